@@ -0,0 +1,194 @@
+package int24
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// EncodeUint24sBE writes the 3-byte big-endian form of each value in src to dst.
+// dst must hold at least 3*len(src) bytes.
+func EncodeUint24sBE(dst []byte, src []Uint24) error {
+	if len(dst) < len(src)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	encodeUint24sBE(dst[:len(src)*3], src)
+	return nil
+}
+
+// EncodeUint24sLE writes the 3-byte little-endian form of each value in src to dst.
+// dst must hold at least 3*len(src) bytes.
+func EncodeUint24sLE(dst []byte, src []Uint24) error {
+	if len(dst) < len(src)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	encodeUint24sLE(dst[:len(src)*3], src)
+	return nil
+}
+
+// EncodeInt24sBE writes the 3-byte big-endian form of each value in src to dst.
+// dst must hold at least 3*len(src) bytes.
+func EncodeInt24sBE(dst []byte, src []Int24) error {
+	if len(dst) < len(src)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	encodeInt24sBE(dst[:len(src)*3], src)
+	return nil
+}
+
+// EncodeInt24sLE writes the 3-byte little-endian form of each value in src to dst.
+// dst must hold at least 3*len(src) bytes.
+func EncodeInt24sLE(dst []byte, src []Int24) error {
+	if len(dst) < len(src)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	encodeInt24sLE(dst[:len(src)*3], src)
+	return nil
+}
+
+// AppendUint24sBE appends the 3-byte big-endian form of each value in src to dst.
+func AppendUint24sBE(dst []byte, src []Uint24) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*3)[:n+len(src)*3]
+	encodeUint24sBE(dst[n:], src)
+	return dst
+}
+
+// AppendUint24sLE appends the 3-byte little-endian form of each value in src to dst.
+func AppendUint24sLE(dst []byte, src []Uint24) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*3)[:n+len(src)*3]
+	encodeUint24sLE(dst[n:], src)
+	return dst
+}
+
+// AppendInt24sBE appends the 3-byte big-endian form of each value in src to dst.
+func AppendInt24sBE(dst []byte, src []Int24) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*3)[:n+len(src)*3]
+	encodeInt24sBE(dst[n:], src)
+	return dst
+}
+
+// AppendInt24sLE appends the 3-byte little-endian form of each value in src to dst.
+func AppendInt24sLE(dst []byte, src []Int24) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*3)[:n+len(src)*3]
+	encodeInt24sLE(dst[n:], src)
+	return dst
+}
+
+// DecodeUint24sBE fills dst from the 3-byte big-endian values at the start of src.
+// src must hold at least 3*len(dst) bytes.
+func DecodeUint24sBE(dst []Uint24, src []byte) error {
+	if len(src) < len(dst)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	i, o := 0, 0
+	// Load a full word per value while one fits and drop the extra bytes.
+	for ; i < len(dst) && o+4 <= len(src); i, o = i+1, o+3 {
+		dst[i].value = binary.BigEndian.Uint32(src[o:]) >> 8
+	}
+	for ; i < len(dst); i, o = i+1, o+3 {
+		dst[i], _ = FromUint24Bytes(src[o : o+3])
+	}
+	return nil
+}
+
+// DecodeUint24sLE fills dst from the 3-byte little-endian values at the start of src.
+// src must hold at least 3*len(dst) bytes.
+func DecodeUint24sLE(dst []Uint24, src []byte) error {
+	if len(src) < len(dst)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+4 <= len(src); i, o = i+1, o+3 {
+		dst[i].value = binary.LittleEndian.Uint32(src[o:]) & 0xFFFFFF
+	}
+	for ; i < len(dst); i, o = i+1, o+3 {
+		dst[i], _ = FromUint24LittleEndianBytes(src[o : o+3])
+	}
+	return nil
+}
+
+// DecodeInt24sBE fills dst from the 3-byte big-endian values at the start of src.
+// src must hold at least 3*len(dst) bytes.
+func DecodeInt24sBE(dst []Int24, src []byte) error {
+	if len(src) < len(dst)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	i, o := 0, 0
+	// The arithmetic shift sign-extends from bit 23.
+	for ; i < len(dst) && o+4 <= len(src); i, o = i+1, o+3 {
+		dst[i].value = int32(binary.BigEndian.Uint32(src[o:])) >> 8
+	}
+	for ; i < len(dst); i, o = i+1, o+3 {
+		dst[i], _ = FromInt24Bytes(src[o : o+3])
+	}
+	return nil
+}
+
+// DecodeInt24sLE fills dst from the 3-byte little-endian values at the start of src.
+// src must hold at least 3*len(dst) bytes.
+func DecodeInt24sLE(dst []Int24, src []byte) error {
+	if len(src) < len(dst)*3 {
+		return ErrInt24InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+4 <= len(src); i, o = i+1, o+3 {
+		dst[i].value = int32(binary.LittleEndian.Uint32(src[o:])<<8) >> 8
+	}
+	for ; i < len(dst); i, o = i+1, o+3 {
+		dst[i], _ = FromInt24LittleEndianBytes(src[o : o+3])
+	}
+	return nil
+}
+
+// encodeUint24sBE requires len(dst) == 3*len(src).
+func encodeUint24sBE(dst []byte, src []Uint24) {
+	i, o := 0, 0
+	// Store a full word per value while it stays inside dst; the extra
+	// bytes are overwritten by the next value.
+	for ; o+4 <= len(dst); i, o = i+1, o+3 {
+		binary.BigEndian.PutUint32(dst[o:], src[i].value<<8)
+	}
+	for ; i < len(src); i, o = i+1, o+3 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeUint24sLE requires len(dst) == 3*len(src).
+func encodeUint24sLE(dst []byte, src []Uint24) {
+	i, o := 0, 0
+	for ; o+4 <= len(dst); i, o = i+1, o+3 {
+		binary.LittleEndian.PutUint32(dst[o:], src[i].value)
+	}
+	for ; i < len(src); i, o = i+1, o+3 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt24sBE requires len(dst) == 3*len(src).
+func encodeInt24sBE(dst []byte, src []Int24) {
+	i, o := 0, 0
+	for ; o+4 <= len(dst); i, o = i+1, o+3 {
+		binary.BigEndian.PutUint32(dst[o:], uint32(src[i].value)<<8)
+	}
+	for ; i < len(src); i, o = i+1, o+3 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt24sLE requires len(dst) == 3*len(src).
+func encodeInt24sLE(dst []byte, src []Int24) {
+	i, o := 0, 0
+	for ; o+4 <= len(dst); i, o = i+1, o+3 {
+		binary.LittleEndian.PutUint32(dst[o:], uint32(src[i].value))
+	}
+	for ; i < len(src); i, o = i+1, o+3 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
@@ -13,6 +13,11 @@ var (
 	ErrUint24OutOfRange       = errors.New("value exceeds maximum for Uint24")
 	ErrInt24InvalidByteLength = errors.New("invalid byte length")
 	ErrInt24EmptyData         = errors.New("empty data")
+	ErrInt24IndexOutOfRange   = errors.New("index out of range")
+	ErrInt24ScanType          = errors.New("unsupported Scan source type")
+	ErrInt24NotIntegral       = errors.New("value is not an integer")
+	ErrInt24InvalidCBOR       = errors.New("invalid CBOR integer")
+	ErrInt24InvalidMsgpack    = errors.New("invalid MessagePack integer")
 )
 
 // Int24 represents a 24-bit signed integer stored in a 32-bit field.
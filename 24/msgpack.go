@@ -0,0 +1,126 @@
+package int24
+
+import "math"
+
+// MarshalMsgpack encodes the Int24 as a MessagePack integer in the smallest
+// format that holds it. It implements the Marshaler interface recognized
+// by github.com/vmihailenco/msgpack.
+func (i Int24) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackInt(nil, i.Int64()), nil
+}
+
+// UnmarshalMsgpack decodes a MessagePack integer of any format into the
+// Int24. Values outside the Int24 range return ErrInt24OutOfRange.
+func (i *Int24) UnmarshalMsgpack(data []byte) error {
+	val, neg, err := readMsgpackInt(data)
+	if err != nil {
+		return err
+	}
+	if !neg && val > math.MaxInt64 {
+		return ErrInt24OutOfRange
+	}
+	newI, err := NewInt24(int64(val))
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalMsgpack encodes the Uint24 as a MessagePack integer in the smallest
+// format that holds it. It implements the Marshaler interface recognized
+// by github.com/vmihailenco/msgpack.
+func (u Uint24) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackInt(nil, int64(u.Uint64())), nil
+}
+
+// UnmarshalMsgpack decodes a MessagePack integer of any format into the
+// Uint24. Negative values and values above the Uint24 maximum return
+// ErrUint24OutOfRange.
+func (u *Uint24) UnmarshalMsgpack(data []byte) error {
+	val, neg, err := readMsgpackInt(data)
+	if err != nil {
+		return err
+	}
+	if neg {
+		return ErrUint24OutOfRange
+	}
+	newU, err := NewUint24(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
+
+// appendMsgpackInt appends v using a fixint, or the narrowest uint format
+// for non-negative values and int format for negative ones.
+func appendMsgpackInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v <= math.MaxInt8:
+		return append(b, byte(v)) // positive fixint
+	case v >= -32 && v < 0:
+		return append(b, byte(v)) // negative fixint
+	case v > 0 && v <= math.MaxUint8:
+		return append(b, 0xcc, byte(v))
+	case v > 0 && v <= math.MaxUint16:
+		return append(b, 0xcd, byte(v>>8), byte(v))
+	case v > 0 && v <= math.MaxUint32:
+		return append(b, 0xce, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	case v > 0:
+		return append(b, 0xcf, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32),
+			byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	case v >= math.MinInt8:
+		return append(b, 0xd0, byte(v))
+	case v >= math.MinInt16:
+		return append(b, 0xd1, byte(v>>8), byte(v))
+	case v >= math.MinInt32:
+		return append(b, 0xd2, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	return append(b, 0xd3, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32),
+		byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// readMsgpackInt decodes a complete MessagePack integer. Negative values are
+// returned as their two's complement bit pattern with neg set.
+func readMsgpackInt(data []byte) (val uint64, neg bool, err error) {
+	if len(data) == 0 {
+		return 0, false, ErrInt24EmptyData
+	}
+	c := data[0]
+	switch {
+	case c <= 0x7f:
+		return uint64(c), false, checkMsgpackLen(data, 1)
+	case c >= 0xe0:
+		return uint64(int64(int8(c))), true, checkMsgpackLen(data, 1)
+	case c >= 0xcc && c <= 0xcf:
+		n := 1 << (c - 0xcc)
+		if err := checkMsgpackLen(data, 1+n); err != nil {
+			return 0, false, err
+		}
+		for _, b := range data[1:] {
+			val = val<<8 | uint64(b)
+		}
+		return val, false, nil
+	case c >= 0xd0 && c <= 0xd3:
+		n := 1 << (c - 0xd0)
+		if err := checkMsgpackLen(data, 1+n); err != nil {
+			return 0, false, err
+		}
+		for _, b := range data[1:] {
+			val = val<<8 | uint64(b)
+		}
+		// Sign-extend from n bytes.
+		shift := 64 - 8*n
+		x := int64(val<<shift) >> shift
+		return uint64(x), x < 0, nil
+	}
+	return 0, false, ErrInt24InvalidMsgpack
+}
+
+func checkMsgpackLen(data []byte, n int) error {
+	if len(data) != n {
+		return ErrInt24InvalidMsgpack
+	}
+	return nil
+}
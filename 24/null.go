@@ -0,0 +1,158 @@
+package int24
+
+import (
+	"database/sql/driver"
+	"strconv"
+)
+
+// NullInt24 represents an Int24 that may be null, like sql.NullInt64.
+type NullInt24 struct {
+	Int24 Int24
+	Valid bool // Valid is true if Int24 is not NULL
+}
+
+// Scan implements sql.Scanner for NullInt24.
+func (n *NullInt24) Scan(src any) error {
+	if src == nil {
+		n.Int24, n.Valid = Int24{}, false
+		return nil
+	}
+	if err := n.Int24.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullInt24.
+func (n NullInt24) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Int24.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullInt24.
+func (n NullInt24) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Int24.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullInt24.
+func (n *NullInt24) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Int24, n.Valid = Int24{}, false
+		return nil
+	}
+	if err := n.Int24.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullInt24.
+// A null value is marshaled as empty text.
+func (n NullInt24) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, n.Int24.Int64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullInt24.
+// Empty text is unmarshaled as null.
+func (n *NullInt24) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Int24, n.Valid = Int24{}, false
+		return nil
+	}
+	val, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt24(val)
+	if err != nil {
+		return err
+	}
+	n.Int24, n.Valid = newI, true
+	return nil
+}
+
+// NullUint24 represents a Uint24 that may be null, like sql.NullInt64.
+type NullUint24 struct {
+	Uint24 Uint24
+	Valid  bool // Valid is true if Uint24 is not NULL
+}
+
+// Scan implements sql.Scanner for NullUint24.
+func (n *NullUint24) Scan(src any) error {
+	if src == nil {
+		n.Uint24, n.Valid = Uint24{}, false
+		return nil
+	}
+	if err := n.Uint24.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullUint24.
+func (n NullUint24) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Uint24.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullUint24.
+func (n NullUint24) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Uint24.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullUint24.
+func (n *NullUint24) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Uint24, n.Valid = Uint24{}, false
+		return nil
+	}
+	if err := n.Uint24.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullUint24.
+// A null value is marshaled as empty text.
+func (n NullUint24) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, n.Uint24.Uint64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullUint24.
+// Empty text is unmarshaled as null.
+func (n *NullUint24) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Uint24, n.Valid = Uint24{}, false
+		return nil
+	}
+	val, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint24(val)
+	if err != nil {
+		return err
+	}
+	n.Uint24, n.Valid = newU, true
+	return nil
+}
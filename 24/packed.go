@@ -0,0 +1,93 @@
+package int24
+
+// PackedInt24 holds an Int24 in exactly 3 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Int24.Pack and
+// PackedInt24.Unpack for arithmetic.
+type PackedInt24 [3]byte
+
+// PackedUint24 holds a Uint24 in exactly 3 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Uint24.Pack and
+// PackedUint24.Unpack for arithmetic.
+type PackedUint24 [3]byte
+
+// Pack returns the packed 3-byte form of the Int24.
+func (i Int24) Pack() PackedInt24 { return PackedInt24(i.ToBytes()) }
+
+// Unpack returns the PackedInt24 as an Int24.
+func (p PackedInt24) Unpack() Int24 {
+	val := int32(p[0])<<16 | int32(p[1])<<8 | int32(p[2])
+	if p[0]&0x80 != 0 {
+		val |= ^0x7FFFFF
+	}
+	return Int24{value: val}
+}
+
+// Int64 returns the PackedInt24 as an int64.
+func (p PackedInt24) Int64() int64 { return p.Unpack().Int64() }
+
+// String returns the string representation of the PackedInt24.
+func (p PackedInt24) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedInt24.
+func (p PackedInt24) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedInt24.
+func (p *PackedInt24) UnmarshalJSON(data []byte) error {
+	var i Int24
+	if err := i.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = i.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedInt24.
+func (p PackedInt24) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedInt24.
+func (p *PackedInt24) UnmarshalBinary(data []byte) error {
+	if len(data) != 3 {
+		return ErrInt24InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
+
+// Pack returns the packed 3-byte form of the Uint24.
+func (u Uint24) Pack() PackedUint24 { return PackedUint24(u.ToBytes()) }
+
+// Unpack returns the PackedUint24 as a Uint24.
+func (p PackedUint24) Unpack() Uint24 {
+	return Uint24{value: uint32(p[0])<<16 | uint32(p[1])<<8 | uint32(p[2])}
+}
+
+// Uint64 returns the PackedUint24 as a uint64.
+func (p PackedUint24) Uint64() uint64 { return p.Unpack().Uint64() }
+
+// String returns the string representation of the PackedUint24.
+func (p PackedUint24) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedUint24.
+func (p PackedUint24) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedUint24.
+func (p *PackedUint24) UnmarshalJSON(data []byte) error {
+	var u Uint24
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = u.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedUint24.
+func (p PackedUint24) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedUint24.
+func (p *PackedUint24) UnmarshalBinary(data []byte) error {
+	if len(data) != 3 {
+		return ErrInt24InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
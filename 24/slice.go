@@ -0,0 +1,204 @@
+package int24
+
+import (
+	"encoding/binary"
+	"iter"
+)
+
+// isLittleEndian reports whether order is little-endian; nil means big-endian.
+func isLittleEndian(order binary.ByteOrder) bool {
+	return order != nil && order.Uint16([]byte{1, 0}) == 1
+}
+
+func byteOrder(little bool) binary.ByteOrder {
+	if little {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// Int24Slice is a sequence of Int24 values stored back to back in a single
+// []byte, 3 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Int24Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewInt24Slice returns a Int24Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewInt24Slice(n int, order binary.ByteOrder) Int24Slice {
+	return Int24Slice{buf: make([]byte, n*3), little: isLittleEndian(order)}
+}
+
+// Int24SliceFromBytes returns a Int24Slice backed by b without copying.
+// len(b) must be a multiple of 3.
+func Int24SliceFromBytes(b []byte, order binary.ByteOrder) (Int24Slice, error) {
+	if len(b)%3 != 0 {
+		return Int24Slice{}, ErrInt24InvalidByteLength
+	}
+	return Int24Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Int24Slice) Len() int { return len(s.buf) / 3 }
+
+// Order returns the byte order of the slice.
+func (s Int24Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Int24Slice) At(i int) Int24 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt24IndexOutOfRange)
+	}
+	b := s.buf[i*3 : i*3+3]
+	if s.little {
+		v, _ := FromInt24LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromInt24Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Int24Slice) Set(i int, v Int24) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt24IndexOutOfRange
+	}
+	var b [3]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*3:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Int24Slice) Append(values ...Int24) Int24Slice {
+	if s.little {
+		s.buf = AppendInt24sLE(s.buf, values)
+	} else {
+		s.buf = AppendInt24sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Int24Slice) Slice(i, j int) Int24Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt24IndexOutOfRange)
+	}
+	return Int24Slice{buf: s.buf[i*3 : j*3], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Int24Slice) All() iter.Seq2[int, Int24] {
+	return func(yield func(int, Int24) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 3 bytes per value.
+func (s Int24Slice) Bytes() []byte { return s.buf }
+
+// Uint24Slice is a sequence of Uint24 values stored back to back in a single
+// []byte, 3 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Uint24Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewUint24Slice returns a Uint24Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewUint24Slice(n int, order binary.ByteOrder) Uint24Slice {
+	return Uint24Slice{buf: make([]byte, n*3), little: isLittleEndian(order)}
+}
+
+// Uint24SliceFromBytes returns a Uint24Slice backed by b without copying.
+// len(b) must be a multiple of 3.
+func Uint24SliceFromBytes(b []byte, order binary.ByteOrder) (Uint24Slice, error) {
+	if len(b)%3 != 0 {
+		return Uint24Slice{}, ErrInt24InvalidByteLength
+	}
+	return Uint24Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Uint24Slice) Len() int { return len(s.buf) / 3 }
+
+// Order returns the byte order of the slice.
+func (s Uint24Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Uint24Slice) At(i int) Uint24 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt24IndexOutOfRange)
+	}
+	b := s.buf[i*3 : i*3+3]
+	if s.little {
+		v, _ := FromUint24LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromUint24Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Uint24Slice) Set(i int, v Uint24) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt24IndexOutOfRange
+	}
+	var b [3]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*3:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Uint24Slice) Append(values ...Uint24) Uint24Slice {
+	if s.little {
+		s.buf = AppendUint24sLE(s.buf, values)
+	} else {
+		s.buf = AppendUint24sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Uint24Slice) Slice(i, j int) Uint24Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt24IndexOutOfRange)
+	}
+	return Uint24Slice{buf: s.buf[i*3 : j*3], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Uint24Slice) All() iter.Seq2[int, Uint24] {
+	return func(yield func(int, Uint24) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 3 bytes per value.
+func (s Uint24Slice) Bytes() []byte { return s.buf }
@@ -0,0 +1,80 @@
+package int24
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// MarshalXML implements xml.Marshaler for Int24, encoding it as decimal
+// character data.
+func (i Int24) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Int24. Surrounding whitespace
+// is ignored, as encoding/xml does for built-in integers.
+func (i *Int24) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return i.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Int24.
+func (i Int24) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Int24.
+func (i *Int24) UnmarshalXMLAttr(attr xml.Attr) error { return i.setXML(attr.Value) }
+
+func (i *Int24) setXML(s string) error {
+	val, err := parseInt64(strings.TrimSpace(s), ErrInt24OutOfRange)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt24(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler for Uint24, encoding it as decimal
+// character data.
+func (u Uint24) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(u.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Uint24. Surrounding
+// whitespace is ignored, as encoding/xml does for built-in integers.
+func (u *Uint24) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return u.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Uint24.
+func (u Uint24) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: u.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Uint24.
+func (u *Uint24) UnmarshalXMLAttr(attr xml.Attr) error { return u.setXML(attr.Value) }
+
+func (u *Uint24) setXML(s string) error {
+	val, err := parseUint64(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint24(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
@@ -0,0 +1,194 @@
+package int40
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// EncodeUint40sBE writes the 5-byte big-endian form of each value in src to dst.
+// dst must hold at least 5*len(src) bytes.
+func EncodeUint40sBE(dst []byte, src []Uint40) error {
+	if len(dst) < len(src)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	encodeUint40sBE(dst[:len(src)*5], src)
+	return nil
+}
+
+// EncodeUint40sLE writes the 5-byte little-endian form of each value in src to dst.
+// dst must hold at least 5*len(src) bytes.
+func EncodeUint40sLE(dst []byte, src []Uint40) error {
+	if len(dst) < len(src)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	encodeUint40sLE(dst[:len(src)*5], src)
+	return nil
+}
+
+// EncodeInt40sBE writes the 5-byte big-endian form of each value in src to dst.
+// dst must hold at least 5*len(src) bytes.
+func EncodeInt40sBE(dst []byte, src []Int40) error {
+	if len(dst) < len(src)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	encodeInt40sBE(dst[:len(src)*5], src)
+	return nil
+}
+
+// EncodeInt40sLE writes the 5-byte little-endian form of each value in src to dst.
+// dst must hold at least 5*len(src) bytes.
+func EncodeInt40sLE(dst []byte, src []Int40) error {
+	if len(dst) < len(src)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	encodeInt40sLE(dst[:len(src)*5], src)
+	return nil
+}
+
+// AppendUint40sBE appends the 5-byte big-endian form of each value in src to dst.
+func AppendUint40sBE(dst []byte, src []Uint40) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*5)[:n+len(src)*5]
+	encodeUint40sBE(dst[n:], src)
+	return dst
+}
+
+// AppendUint40sLE appends the 5-byte little-endian form of each value in src to dst.
+func AppendUint40sLE(dst []byte, src []Uint40) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*5)[:n+len(src)*5]
+	encodeUint40sLE(dst[n:], src)
+	return dst
+}
+
+// AppendInt40sBE appends the 5-byte big-endian form of each value in src to dst.
+func AppendInt40sBE(dst []byte, src []Int40) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*5)[:n+len(src)*5]
+	encodeInt40sBE(dst[n:], src)
+	return dst
+}
+
+// AppendInt40sLE appends the 5-byte little-endian form of each value in src to dst.
+func AppendInt40sLE(dst []byte, src []Int40) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*5)[:n+len(src)*5]
+	encodeInt40sLE(dst[n:], src)
+	return dst
+}
+
+// DecodeUint40sBE fills dst from the 5-byte big-endian values at the start of src.
+// src must hold at least 5*len(dst) bytes.
+func DecodeUint40sBE(dst []Uint40, src []byte) error {
+	if len(src) < len(dst)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	i, o := 0, 0
+	// Load a full word per value while one fits and drop the extra bytes.
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+5 {
+		dst[i].value = binary.BigEndian.Uint64(src[o:]) >> 24
+	}
+	for ; i < len(dst); i, o = i+1, o+5 {
+		dst[i], _ = FromUint40Bytes(src[o : o+5])
+	}
+	return nil
+}
+
+// DecodeUint40sLE fills dst from the 5-byte little-endian values at the start of src.
+// src must hold at least 5*len(dst) bytes.
+func DecodeUint40sLE(dst []Uint40, src []byte) error {
+	if len(src) < len(dst)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+5 {
+		dst[i].value = binary.LittleEndian.Uint64(src[o:]) & 0xFFFFFFFFFF
+	}
+	for ; i < len(dst); i, o = i+1, o+5 {
+		dst[i], _ = FromUint40LittleEndianBytes(src[o : o+5])
+	}
+	return nil
+}
+
+// DecodeInt40sBE fills dst from the 5-byte big-endian values at the start of src.
+// src must hold at least 5*len(dst) bytes.
+func DecodeInt40sBE(dst []Int40, src []byte) error {
+	if len(src) < len(dst)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	i, o := 0, 0
+	// The arithmetic shift sign-extends from bit 39.
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+5 {
+		dst[i].value = int64(binary.BigEndian.Uint64(src[o:])) >> 24
+	}
+	for ; i < len(dst); i, o = i+1, o+5 {
+		dst[i], _ = FromInt40Bytes(src[o : o+5])
+	}
+	return nil
+}
+
+// DecodeInt40sLE fills dst from the 5-byte little-endian values at the start of src.
+// src must hold at least 5*len(dst) bytes.
+func DecodeInt40sLE(dst []Int40, src []byte) error {
+	if len(src) < len(dst)*5 {
+		return ErrInt40InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+5 {
+		dst[i].value = int64(binary.LittleEndian.Uint64(src[o:])<<24) >> 24
+	}
+	for ; i < len(dst); i, o = i+1, o+5 {
+		dst[i], _ = FromInt40LittleEndianBytes(src[o : o+5])
+	}
+	return nil
+}
+
+// encodeUint40sBE requires len(dst) == 5*len(src).
+func encodeUint40sBE(dst []byte, src []Uint40) {
+	i, o := 0, 0
+	// Store a full word per value while it stays inside dst; the extra
+	// bytes are overwritten by the next value.
+	for ; o+8 <= len(dst); i, o = i+1, o+5 {
+		binary.BigEndian.PutUint64(dst[o:], src[i].value<<24)
+	}
+	for ; i < len(src); i, o = i+1, o+5 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeUint40sLE requires len(dst) == 5*len(src).
+func encodeUint40sLE(dst []byte, src []Uint40) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+5 {
+		binary.LittleEndian.PutUint64(dst[o:], src[i].value)
+	}
+	for ; i < len(src); i, o = i+1, o+5 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt40sBE requires len(dst) == 5*len(src).
+func encodeInt40sBE(dst []byte, src []Int40) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+5 {
+		binary.BigEndian.PutUint64(dst[o:], uint64(src[i].value)<<24)
+	}
+	for ; i < len(src); i, o = i+1, o+5 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt40sLE requires len(dst) == 5*len(src).
+func encodeInt40sLE(dst []byte, src []Int40) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+5 {
+		binary.LittleEndian.PutUint64(dst[o:], uint64(src[i].value))
+	}
+	for ; i < len(src); i, o = i+1, o+5 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
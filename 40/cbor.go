@@ -0,0 +1,110 @@
+package int40
+
+import "math"
+
+// MarshalCBOR encodes the Int40 as a CBOR (RFC 8949) integer, using major
+// type 0 or 1 with the shortest argument.
+func (i Int40) MarshalCBOR() ([]byte, error) {
+	v := i.Int64()
+	if v < 0 {
+		return appendCBORHead(nil, 1, uint64(-1-v)), nil
+	}
+	return appendCBORHead(nil, 0, uint64(v)), nil
+}
+
+// UnmarshalCBOR decodes a CBOR integer of major type 0 or 1 into the Int40.
+// Any argument width is accepted; values outside the Int40 range return
+// ErrInt40OutOfRange.
+func (i *Int40) UnmarshalCBOR(data []byte) error {
+	major, arg, err := readCBORInt(data)
+	if err != nil {
+		return err
+	}
+	if arg > math.MaxInt64 {
+		return ErrInt40OutOfRange
+	}
+	val := int64(arg)
+	if major == 1 {
+		val = -1 - val
+	}
+	newI, err := NewInt40(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalCBOR encodes the Uint40 as a CBOR (RFC 8949) unsigned integer,
+// using major type 0 with the shortest argument.
+func (u Uint40) MarshalCBOR() ([]byte, error) {
+	return appendCBORHead(nil, 0, u.Uint64()), nil
+}
+
+// UnmarshalCBOR decodes a CBOR integer of major type 0 or 1 into the Uint40.
+// Any argument width is accepted; negative values and values above the
+// Uint40 maximum return ErrUint40OutOfRange.
+func (u *Uint40) UnmarshalCBOR(data []byte) error {
+	major, arg, err := readCBORInt(data)
+	if err != nil {
+		return err
+	}
+	if major == 1 {
+		return ErrUint40OutOfRange
+	}
+	newU, err := NewUint40(arg)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
+
+// appendCBORHead appends a CBOR initial byte and argument in shortest form.
+func appendCBORHead(b []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(b, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return append(b, major|25, byte(arg>>8), byte(arg))
+	case arg <= math.MaxUint32:
+		return append(b, major|26, byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+	}
+	return append(b, major|27, byte(arg>>56), byte(arg>>48), byte(arg>>40), byte(arg>>32),
+		byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+}
+
+// readCBORInt decodes a complete CBOR integer data item, returning its major
+// type (0 or 1) and argument.
+func readCBORInt(data []byte) (byte, uint64, error) {
+	if len(data) == 0 {
+		return 0, 0, ErrInt40EmptyData
+	}
+	major, info := data[0]>>5, data[0]&0x1F
+	if major > 1 {
+		return 0, 0, ErrInt40InvalidCBOR
+	}
+	var n int
+	switch {
+	case info < 24:
+		n = 0
+	case info <= 27:
+		n = 1 << (info - 24)
+	default:
+		return 0, 0, ErrInt40InvalidCBOR
+	}
+	if len(data) != 1+n {
+		return 0, 0, ErrInt40InvalidCBOR
+	}
+	if n == 0 {
+		return major, uint64(info), nil
+	}
+	var arg uint64
+	for _, c := range data[1:] {
+		arg = arg<<8 | uint64(c)
+	}
+	return major, arg, nil
+}
@@ -13,6 +13,11 @@ var (
 	ErrUint40OutOfRange       = errors.New("value exceeds maximum for Uint40")
 	ErrInt40InvalidByteLength = errors.New("invalid byte length")
 	ErrInt40EmptyData         = errors.New("empty data")
+	ErrInt40IndexOutOfRange   = errors.New("index out of range")
+	ErrInt40ScanType          = errors.New("unsupported Scan source type")
+	ErrInt40NotIntegral       = errors.New("value is not an integer")
+	ErrInt40InvalidCBOR       = errors.New("invalid CBOR integer")
+	ErrInt40InvalidMsgpack    = errors.New("invalid MessagePack integer")
 )
 
 // Int40 represents a 40-bit signed integer stored in a 64-bit field.
@@ -0,0 +1,126 @@
+package int40
+
+import "math"
+
+// MarshalMsgpack encodes the Int40 as a MessagePack integer in the smallest
+// format that holds it. It implements the Marshaler interface recognized
+// by github.com/vmihailenco/msgpack.
+func (i Int40) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackInt(nil, i.Int64()), nil
+}
+
+// UnmarshalMsgpack decodes a MessagePack integer of any format into the
+// Int40. Values outside the Int40 range return ErrInt40OutOfRange.
+func (i *Int40) UnmarshalMsgpack(data []byte) error {
+	val, neg, err := readMsgpackInt(data)
+	if err != nil {
+		return err
+	}
+	if !neg && val > math.MaxInt64 {
+		return ErrInt40OutOfRange
+	}
+	newI, err := NewInt40(int64(val))
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalMsgpack encodes the Uint40 as a MessagePack integer in the smallest
+// format that holds it. It implements the Marshaler interface recognized
+// by github.com/vmihailenco/msgpack.
+func (u Uint40) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackInt(nil, int64(u.Uint64())), nil
+}
+
+// UnmarshalMsgpack decodes a MessagePack integer of any format into the
+// Uint40. Negative values and values above the Uint40 maximum return
+// ErrUint40OutOfRange.
+func (u *Uint40) UnmarshalMsgpack(data []byte) error {
+	val, neg, err := readMsgpackInt(data)
+	if err != nil {
+		return err
+	}
+	if neg {
+		return ErrUint40OutOfRange
+	}
+	newU, err := NewUint40(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
+
+// appendMsgpackInt appends v using a fixint, or the narrowest uint format
+// for non-negative values and int format for negative ones.
+func appendMsgpackInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v <= math.MaxInt8:
+		return append(b, byte(v)) // positive fixint
+	case v >= -32 && v < 0:
+		return append(b, byte(v)) // negative fixint
+	case v > 0 && v <= math.MaxUint8:
+		return append(b, 0xcc, byte(v))
+	case v > 0 && v <= math.MaxUint16:
+		return append(b, 0xcd, byte(v>>8), byte(v))
+	case v > 0 && v <= math.MaxUint32:
+		return append(b, 0xce, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	case v > 0:
+		return append(b, 0xcf, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32),
+			byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	case v >= math.MinInt8:
+		return append(b, 0xd0, byte(v))
+	case v >= math.MinInt16:
+		return append(b, 0xd1, byte(v>>8), byte(v))
+	case v >= math.MinInt32:
+		return append(b, 0xd2, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	return append(b, 0xd3, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32),
+		byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// readMsgpackInt decodes a complete MessagePack integer. Negative values are
+// returned as their two's complement bit pattern with neg set.
+func readMsgpackInt(data []byte) (val uint64, neg bool, err error) {
+	if len(data) == 0 {
+		return 0, false, ErrInt40EmptyData
+	}
+	c := data[0]
+	switch {
+	case c <= 0x7f:
+		return uint64(c), false, checkMsgpackLen(data, 1)
+	case c >= 0xe0:
+		return uint64(int64(int8(c))), true, checkMsgpackLen(data, 1)
+	case c >= 0xcc && c <= 0xcf:
+		n := 1 << (c - 0xcc)
+		if err := checkMsgpackLen(data, 1+n); err != nil {
+			return 0, false, err
+		}
+		for _, b := range data[1:] {
+			val = val<<8 | uint64(b)
+		}
+		return val, false, nil
+	case c >= 0xd0 && c <= 0xd3:
+		n := 1 << (c - 0xd0)
+		if err := checkMsgpackLen(data, 1+n); err != nil {
+			return 0, false, err
+		}
+		for _, b := range data[1:] {
+			val = val<<8 | uint64(b)
+		}
+		// Sign-extend from n bytes.
+		shift := 64 - 8*n
+		x := int64(val<<shift) >> shift
+		return uint64(x), x < 0, nil
+	}
+	return 0, false, ErrInt40InvalidMsgpack
+}
+
+func checkMsgpackLen(data []byte, n int) error {
+	if len(data) != n {
+		return ErrInt40InvalidMsgpack
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package int40
+
+import (
+	"database/sql/driver"
+	"strconv"
+)
+
+// NullInt40 represents an Int40 that may be null, like sql.NullInt64.
+type NullInt40 struct {
+	Int40 Int40
+	Valid bool // Valid is true if Int40 is not NULL
+}
+
+// Scan implements sql.Scanner for NullInt40.
+func (n *NullInt40) Scan(src any) error {
+	if src == nil {
+		n.Int40, n.Valid = Int40{}, false
+		return nil
+	}
+	if err := n.Int40.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullInt40.
+func (n NullInt40) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Int40.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullInt40.
+func (n NullInt40) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Int40.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullInt40.
+func (n *NullInt40) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Int40, n.Valid = Int40{}, false
+		return nil
+	}
+	if err := n.Int40.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullInt40.
+// A null value is marshaled as empty text.
+func (n NullInt40) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, n.Int40.Int64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullInt40.
+// Empty text is unmarshaled as null.
+func (n *NullInt40) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Int40, n.Valid = Int40{}, false
+		return nil
+	}
+	val, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt40(val)
+	if err != nil {
+		return err
+	}
+	n.Int40, n.Valid = newI, true
+	return nil
+}
+
+// NullUint40 represents a Uint40 that may be null, like sql.NullInt64.
+type NullUint40 struct {
+	Uint40 Uint40
+	Valid  bool // Valid is true if Uint40 is not NULL
+}
+
+// Scan implements sql.Scanner for NullUint40.
+func (n *NullUint40) Scan(src any) error {
+	if src == nil {
+		n.Uint40, n.Valid = Uint40{}, false
+		return nil
+	}
+	if err := n.Uint40.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullUint40.
+func (n NullUint40) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Uint40.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullUint40.
+func (n NullUint40) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Uint40.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullUint40.
+func (n *NullUint40) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Uint40, n.Valid = Uint40{}, false
+		return nil
+	}
+	if err := n.Uint40.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullUint40.
+// A null value is marshaled as empty text.
+func (n NullUint40) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, n.Uint40.Uint64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullUint40.
+// Empty text is unmarshaled as null.
+func (n *NullUint40) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Uint40, n.Valid = Uint40{}, false
+		return nil
+	}
+	val, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint40(val)
+	if err != nil {
+		return err
+	}
+	n.Uint40, n.Valid = newU, true
+	return nil
+}
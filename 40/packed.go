@@ -0,0 +1,93 @@
+package int40
+
+// PackedInt40 holds an Int40 in exactly 5 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Int40.Pack and
+// PackedInt40.Unpack for arithmetic.
+type PackedInt40 [5]byte
+
+// PackedUint40 holds a Uint40 in exactly 5 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Uint40.Pack and
+// PackedUint40.Unpack for arithmetic.
+type PackedUint40 [5]byte
+
+// Pack returns the packed 5-byte form of the Int40.
+func (i Int40) Pack() PackedInt40 { return PackedInt40(i.ToBytes()) }
+
+// Unpack returns the PackedInt40 as an Int40.
+func (p PackedInt40) Unpack() Int40 {
+	val := int64(p[0])<<32 | int64(p[1])<<24 | int64(p[2])<<16 | int64(p[3])<<8 | int64(p[4])
+	if p[0]&0x80 != 0 {
+		val |= ^0x7FFFFFFFFF
+	}
+	return Int40{value: val}
+}
+
+// Int64 returns the PackedInt40 as an int64.
+func (p PackedInt40) Int64() int64 { return p.Unpack().Int64() }
+
+// String returns the string representation of the PackedInt40.
+func (p PackedInt40) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedInt40.
+func (p PackedInt40) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedInt40.
+func (p *PackedInt40) UnmarshalJSON(data []byte) error {
+	var i Int40
+	if err := i.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = i.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedInt40.
+func (p PackedInt40) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedInt40.
+func (p *PackedInt40) UnmarshalBinary(data []byte) error {
+	if len(data) != 5 {
+		return ErrInt40InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
+
+// Pack returns the packed 5-byte form of the Uint40.
+func (u Uint40) Pack() PackedUint40 { return PackedUint40(u.ToBytes()) }
+
+// Unpack returns the PackedUint40 as a Uint40.
+func (p PackedUint40) Unpack() Uint40 {
+	return Uint40{value: uint64(p[0])<<32 | uint64(p[1])<<24 | uint64(p[2])<<16 | uint64(p[3])<<8 | uint64(p[4])}
+}
+
+// Uint64 returns the PackedUint40 as a uint64.
+func (p PackedUint40) Uint64() uint64 { return p.Unpack().Uint64() }
+
+// String returns the string representation of the PackedUint40.
+func (p PackedUint40) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedUint40.
+func (p PackedUint40) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedUint40.
+func (p *PackedUint40) UnmarshalJSON(data []byte) error {
+	var u Uint40
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = u.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedUint40.
+func (p PackedUint40) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedUint40.
+func (p *PackedUint40) UnmarshalBinary(data []byte) error {
+	if len(data) != 5 {
+		return ErrInt40InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
@@ -0,0 +1,204 @@
+package int40
+
+import (
+	"encoding/binary"
+	"iter"
+)
+
+// isLittleEndian reports whether order is little-endian; nil means big-endian.
+func isLittleEndian(order binary.ByteOrder) bool {
+	return order != nil && order.Uint16([]byte{1, 0}) == 1
+}
+
+func byteOrder(little bool) binary.ByteOrder {
+	if little {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// Int40Slice is a sequence of Int40 values stored back to back in a single
+// []byte, 5 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Int40Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewInt40Slice returns a Int40Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewInt40Slice(n int, order binary.ByteOrder) Int40Slice {
+	return Int40Slice{buf: make([]byte, n*5), little: isLittleEndian(order)}
+}
+
+// Int40SliceFromBytes returns a Int40Slice backed by b without copying.
+// len(b) must be a multiple of 5.
+func Int40SliceFromBytes(b []byte, order binary.ByteOrder) (Int40Slice, error) {
+	if len(b)%5 != 0 {
+		return Int40Slice{}, ErrInt40InvalidByteLength
+	}
+	return Int40Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Int40Slice) Len() int { return len(s.buf) / 5 }
+
+// Order returns the byte order of the slice.
+func (s Int40Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Int40Slice) At(i int) Int40 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt40IndexOutOfRange)
+	}
+	b := s.buf[i*5 : i*5+5]
+	if s.little {
+		v, _ := FromInt40LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromInt40Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Int40Slice) Set(i int, v Int40) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt40IndexOutOfRange
+	}
+	var b [5]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*5:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Int40Slice) Append(values ...Int40) Int40Slice {
+	if s.little {
+		s.buf = AppendInt40sLE(s.buf, values)
+	} else {
+		s.buf = AppendInt40sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Int40Slice) Slice(i, j int) Int40Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt40IndexOutOfRange)
+	}
+	return Int40Slice{buf: s.buf[i*5 : j*5], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Int40Slice) All() iter.Seq2[int, Int40] {
+	return func(yield func(int, Int40) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 5 bytes per value.
+func (s Int40Slice) Bytes() []byte { return s.buf }
+
+// Uint40Slice is a sequence of Uint40 values stored back to back in a single
+// []byte, 5 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Uint40Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewUint40Slice returns a Uint40Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewUint40Slice(n int, order binary.ByteOrder) Uint40Slice {
+	return Uint40Slice{buf: make([]byte, n*5), little: isLittleEndian(order)}
+}
+
+// Uint40SliceFromBytes returns a Uint40Slice backed by b without copying.
+// len(b) must be a multiple of 5.
+func Uint40SliceFromBytes(b []byte, order binary.ByteOrder) (Uint40Slice, error) {
+	if len(b)%5 != 0 {
+		return Uint40Slice{}, ErrInt40InvalidByteLength
+	}
+	return Uint40Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Uint40Slice) Len() int { return len(s.buf) / 5 }
+
+// Order returns the byte order of the slice.
+func (s Uint40Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Uint40Slice) At(i int) Uint40 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt40IndexOutOfRange)
+	}
+	b := s.buf[i*5 : i*5+5]
+	if s.little {
+		v, _ := FromUint40LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromUint40Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Uint40Slice) Set(i int, v Uint40) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt40IndexOutOfRange
+	}
+	var b [5]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*5:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Uint40Slice) Append(values ...Uint40) Uint40Slice {
+	if s.little {
+		s.buf = AppendUint40sLE(s.buf, values)
+	} else {
+		s.buf = AppendUint40sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Uint40Slice) Slice(i, j int) Uint40Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt40IndexOutOfRange)
+	}
+	return Uint40Slice{buf: s.buf[i*5 : j*5], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Uint40Slice) All() iter.Seq2[int, Uint40] {
+	return func(yield func(int, Uint40) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 5 bytes per value.
+func (s Uint40Slice) Bytes() []byte { return s.buf }
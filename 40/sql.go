@@ -0,0 +1,120 @@
+package int40
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// Scan implements sql.Scanner for Int40. It accepts int64, uint64, float64
+// with an integral value, and decimal []byte or string.
+func (i *Int40) Scan(src any) error {
+	val, err := scanInt64(src, ErrInt40OutOfRange)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt40(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// Value implements driver.Valuer for Int40.
+func (i Int40) Value() (driver.Value, error) { return i.Int64(), nil }
+
+// Scan implements sql.Scanner for Uint40. It accepts int64, uint64, float64
+// with an integral value, and decimal []byte or string.
+func (u *Uint40) Scan(src any) error {
+	val, err := scanUint64(src)
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint40(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
+
+// Value implements driver.Valuer for Uint40. Every Uint40 fits in an int64,
+// the integer type drivers must accept.
+func (u Uint40) Value() (driver.Value, error) { return int64(u.Uint64()), nil }
+
+// scanInt64 converts a database value to an int64, reporting values outside
+// the int64 range as outOfRange.
+func scanInt64(src any, outOfRange error) (int64, error) {
+	switch v := src.(type) {
+	case int64:
+		return v, nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, outOfRange
+		}
+		return int64(v), nil
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return 0, ErrInt40NotIntegral
+		}
+		if v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, outOfRange
+		}
+		return int64(v), nil
+	case []byte:
+		return parseInt64(string(v), outOfRange)
+	case string:
+		return parseInt64(v, outOfRange)
+	}
+	return 0, ErrInt40ScanType
+}
+
+func parseInt64(s string, outOfRange error) (int64, error) {
+	val, err := strconv.ParseInt(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, outOfRange
+	}
+	return val, err
+}
+
+// scanUint64 converts a database value to a uint64.
+func scanUint64(src any) (uint64, error) {
+	switch v := src.(type) {
+	case int64:
+		if v < 0 {
+			return 0, ErrUint40OutOfRange
+		}
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return 0, ErrInt40NotIntegral
+		}
+		if v < 0 || v >= math.MaxUint64 {
+			return 0, ErrUint40OutOfRange
+		}
+		return uint64(v), nil
+	case []byte:
+		return parseUint64(string(v))
+	case string:
+		return parseUint64(v)
+	}
+	return 0, ErrInt40ScanType
+}
+
+func parseUint64(s string) (uint64, error) {
+	val, err := strconv.ParseUint(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrUint40OutOfRange
+	}
+	if err != nil {
+		// A well-formed negative number is out of range, not a syntax error.
+		if neg, nerr := strconv.ParseInt(s, 10, 64); (nerr == nil && neg < 0) || errors.Is(nerr, strconv.ErrRange) {
+			return 0, ErrUint40OutOfRange
+		}
+	}
+	return val, err
+}
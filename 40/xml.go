@@ -0,0 +1,80 @@
+package int40
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// MarshalXML implements xml.Marshaler for Int40, encoding it as decimal
+// character data.
+func (i Int40) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Int40. Surrounding whitespace
+// is ignored, as encoding/xml does for built-in integers.
+func (i *Int40) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return i.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Int40.
+func (i Int40) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Int40.
+func (i *Int40) UnmarshalXMLAttr(attr xml.Attr) error { return i.setXML(attr.Value) }
+
+func (i *Int40) setXML(s string) error {
+	val, err := parseInt64(strings.TrimSpace(s), ErrInt40OutOfRange)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt40(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler for Uint40, encoding it as decimal
+// character data.
+func (u Uint40) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(u.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Uint40. Surrounding
+// whitespace is ignored, as encoding/xml does for built-in integers.
+func (u *Uint40) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return u.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Uint40.
+func (u Uint40) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: u.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Uint40.
+func (u *Uint40) UnmarshalXMLAttr(attr xml.Attr) error { return u.setXML(attr.Value) }
+
+func (u *Uint40) setXML(s string) error {
+	val, err := parseUint64(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint40(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
@@ -0,0 +1,194 @@
+package int48
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// EncodeUint48sBE writes the 6-byte big-endian form of each value in src to dst.
+// dst must hold at least 6*len(src) bytes.
+func EncodeUint48sBE(dst []byte, src []Uint48) error {
+	if len(dst) < len(src)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	encodeUint48sBE(dst[:len(src)*6], src)
+	return nil
+}
+
+// EncodeUint48sLE writes the 6-byte little-endian form of each value in src to dst.
+// dst must hold at least 6*len(src) bytes.
+func EncodeUint48sLE(dst []byte, src []Uint48) error {
+	if len(dst) < len(src)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	encodeUint48sLE(dst[:len(src)*6], src)
+	return nil
+}
+
+// EncodeInt48sBE writes the 6-byte big-endian form of each value in src to dst.
+// dst must hold at least 6*len(src) bytes.
+func EncodeInt48sBE(dst []byte, src []Int48) error {
+	if len(dst) < len(src)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	encodeInt48sBE(dst[:len(src)*6], src)
+	return nil
+}
+
+// EncodeInt48sLE writes the 6-byte little-endian form of each value in src to dst.
+// dst must hold at least 6*len(src) bytes.
+func EncodeInt48sLE(dst []byte, src []Int48) error {
+	if len(dst) < len(src)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	encodeInt48sLE(dst[:len(src)*6], src)
+	return nil
+}
+
+// AppendUint48sBE appends the 6-byte big-endian form of each value in src to dst.
+func AppendUint48sBE(dst []byte, src []Uint48) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*6)[:n+len(src)*6]
+	encodeUint48sBE(dst[n:], src)
+	return dst
+}
+
+// AppendUint48sLE appends the 6-byte little-endian form of each value in src to dst.
+func AppendUint48sLE(dst []byte, src []Uint48) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*6)[:n+len(src)*6]
+	encodeUint48sLE(dst[n:], src)
+	return dst
+}
+
+// AppendInt48sBE appends the 6-byte big-endian form of each value in src to dst.
+func AppendInt48sBE(dst []byte, src []Int48) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*6)[:n+len(src)*6]
+	encodeInt48sBE(dst[n:], src)
+	return dst
+}
+
+// AppendInt48sLE appends the 6-byte little-endian form of each value in src to dst.
+func AppendInt48sLE(dst []byte, src []Int48) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*6)[:n+len(src)*6]
+	encodeInt48sLE(dst[n:], src)
+	return dst
+}
+
+// DecodeUint48sBE fills dst from the 6-byte big-endian values at the start of src.
+// src must hold at least 6*len(dst) bytes.
+func DecodeUint48sBE(dst []Uint48, src []byte) error {
+	if len(src) < len(dst)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	i, o := 0, 0
+	// Load a full word per value while one fits and drop the extra bytes.
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+6 {
+		dst[i].value = binary.BigEndian.Uint64(src[o:]) >> 16
+	}
+	for ; i < len(dst); i, o = i+1, o+6 {
+		dst[i], _ = FromUint48Bytes(src[o : o+6])
+	}
+	return nil
+}
+
+// DecodeUint48sLE fills dst from the 6-byte little-endian values at the start of src.
+// src must hold at least 6*len(dst) bytes.
+func DecodeUint48sLE(dst []Uint48, src []byte) error {
+	if len(src) < len(dst)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+6 {
+		dst[i].value = binary.LittleEndian.Uint64(src[o:]) & 0xFFFFFFFFFFFF
+	}
+	for ; i < len(dst); i, o = i+1, o+6 {
+		dst[i], _ = FromUint48LittleEndianBytes(src[o : o+6])
+	}
+	return nil
+}
+
+// DecodeInt48sBE fills dst from the 6-byte big-endian values at the start of src.
+// src must hold at least 6*len(dst) bytes.
+func DecodeInt48sBE(dst []Int48, src []byte) error {
+	if len(src) < len(dst)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	i, o := 0, 0
+	// The arithmetic shift sign-extends from bit 47.
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+6 {
+		dst[i].value = int64(binary.BigEndian.Uint64(src[o:])) >> 16
+	}
+	for ; i < len(dst); i, o = i+1, o+6 {
+		dst[i], _ = FromInt48Bytes(src[o : o+6])
+	}
+	return nil
+}
+
+// DecodeInt48sLE fills dst from the 6-byte little-endian values at the start of src.
+// src must hold at least 6*len(dst) bytes.
+func DecodeInt48sLE(dst []Int48, src []byte) error {
+	if len(src) < len(dst)*6 {
+		return ErrInt48InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+6 {
+		dst[i].value = int64(binary.LittleEndian.Uint64(src[o:])<<16) >> 16
+	}
+	for ; i < len(dst); i, o = i+1, o+6 {
+		dst[i], _ = FromInt48LittleEndianBytes(src[o : o+6])
+	}
+	return nil
+}
+
+// encodeUint48sBE requires len(dst) == 6*len(src).
+func encodeUint48sBE(dst []byte, src []Uint48) {
+	i, o := 0, 0
+	// Store a full word per value while it stays inside dst; the extra
+	// bytes are overwritten by the next value.
+	for ; o+8 <= len(dst); i, o = i+1, o+6 {
+		binary.BigEndian.PutUint64(dst[o:], src[i].value<<16)
+	}
+	for ; i < len(src); i, o = i+1, o+6 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeUint48sLE requires len(dst) == 6*len(src).
+func encodeUint48sLE(dst []byte, src []Uint48) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+6 {
+		binary.LittleEndian.PutUint64(dst[o:], src[i].value)
+	}
+	for ; i < len(src); i, o = i+1, o+6 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt48sBE requires len(dst) == 6*len(src).
+func encodeInt48sBE(dst []byte, src []Int48) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+6 {
+		binary.BigEndian.PutUint64(dst[o:], uint64(src[i].value)<<16)
+	}
+	for ; i < len(src); i, o = i+1, o+6 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt48sLE requires len(dst) == 6*len(src).
+func encodeInt48sLE(dst []byte, src []Int48) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+6 {
+		binary.LittleEndian.PutUint64(dst[o:], uint64(src[i].value))
+	}
+	for ; i < len(src); i, o = i+1, o+6 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
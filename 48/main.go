@@ -13,6 +13,11 @@ var (
 	ErrUint48OutOfRange       = errors.New("value exceeds maximum for Uint48")
 	ErrInt48InvalidByteLength = errors.New("invalid byte length")
 	ErrInt48EmptyData         = errors.New("empty data")
+	ErrInt48IndexOutOfRange   = errors.New("index out of range")
+	ErrInt48ScanType          = errors.New("unsupported Scan source type")
+	ErrInt48NotIntegral       = errors.New("value is not an integer")
+	ErrInt48InvalidCBOR       = errors.New("invalid CBOR integer")
+	ErrInt48InvalidMsgpack    = errors.New("invalid MessagePack integer")
 )
 
 // Int48 represents a 48-bit signed integer stored in a 64-bit field.
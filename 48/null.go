@@ -0,0 +1,158 @@
+package int48
+
+import (
+	"database/sql/driver"
+	"strconv"
+)
+
+// NullInt48 represents an Int48 that may be null, like sql.NullInt64.
+type NullInt48 struct {
+	Int48 Int48
+	Valid bool // Valid is true if Int48 is not NULL
+}
+
+// Scan implements sql.Scanner for NullInt48.
+func (n *NullInt48) Scan(src any) error {
+	if src == nil {
+		n.Int48, n.Valid = Int48{}, false
+		return nil
+	}
+	if err := n.Int48.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullInt48.
+func (n NullInt48) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Int48.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullInt48.
+func (n NullInt48) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Int48.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullInt48.
+func (n *NullInt48) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Int48, n.Valid = Int48{}, false
+		return nil
+	}
+	if err := n.Int48.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullInt48.
+// A null value is marshaled as empty text.
+func (n NullInt48) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, n.Int48.Int64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullInt48.
+// Empty text is unmarshaled as null.
+func (n *NullInt48) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Int48, n.Valid = Int48{}, false
+		return nil
+	}
+	val, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt48(val)
+	if err != nil {
+		return err
+	}
+	n.Int48, n.Valid = newI, true
+	return nil
+}
+
+// NullUint48 represents a Uint48 that may be null, like sql.NullInt64.
+type NullUint48 struct {
+	Uint48 Uint48
+	Valid  bool // Valid is true if Uint48 is not NULL
+}
+
+// Scan implements sql.Scanner for NullUint48.
+func (n *NullUint48) Scan(src any) error {
+	if src == nil {
+		n.Uint48, n.Valid = Uint48{}, false
+		return nil
+	}
+	if err := n.Uint48.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullUint48.
+func (n NullUint48) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Uint48.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullUint48.
+func (n NullUint48) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Uint48.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullUint48.
+func (n *NullUint48) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Uint48, n.Valid = Uint48{}, false
+		return nil
+	}
+	if err := n.Uint48.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullUint48.
+// A null value is marshaled as empty text.
+func (n NullUint48) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, n.Uint48.Uint64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullUint48.
+// Empty text is unmarshaled as null.
+func (n *NullUint48) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Uint48, n.Valid = Uint48{}, false
+		return nil
+	}
+	val, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint48(val)
+	if err != nil {
+		return err
+	}
+	n.Uint48, n.Valid = newU, true
+	return nil
+}
@@ -0,0 +1,93 @@
+package int48
+
+// PackedInt48 holds an Int48 in exactly 6 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Int48.Pack and
+// PackedInt48.Unpack for arithmetic.
+type PackedInt48 [6]byte
+
+// PackedUint48 holds a Uint48 in exactly 6 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Uint48.Pack and
+// PackedUint48.Unpack for arithmetic.
+type PackedUint48 [6]byte
+
+// Pack returns the packed 6-byte form of the Int48.
+func (i Int48) Pack() PackedInt48 { return PackedInt48(i.ToBytes()) }
+
+// Unpack returns the PackedInt48 as an Int48.
+func (p PackedInt48) Unpack() Int48 {
+	val := int64(p[0])<<40 | int64(p[1])<<32 | int64(p[2])<<24 | int64(p[3])<<16 | int64(p[4])<<8 | int64(p[5])
+	if p[0]&0x80 != 0 {
+		val |= ^0x7FFFFFFFFFFF
+	}
+	return Int48{value: val}
+}
+
+// Int64 returns the PackedInt48 as an int64.
+func (p PackedInt48) Int64() int64 { return p.Unpack().Int64() }
+
+// String returns the string representation of the PackedInt48.
+func (p PackedInt48) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedInt48.
+func (p PackedInt48) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedInt48.
+func (p *PackedInt48) UnmarshalJSON(data []byte) error {
+	var i Int48
+	if err := i.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = i.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedInt48.
+func (p PackedInt48) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedInt48.
+func (p *PackedInt48) UnmarshalBinary(data []byte) error {
+	if len(data) != 6 {
+		return ErrInt48InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
+
+// Pack returns the packed 6-byte form of the Uint48.
+func (u Uint48) Pack() PackedUint48 { return PackedUint48(u.ToBytes()) }
+
+// Unpack returns the PackedUint48 as a Uint48.
+func (p PackedUint48) Unpack() Uint48 {
+	return Uint48{value: uint64(p[0])<<40 | uint64(p[1])<<32 | uint64(p[2])<<24 | uint64(p[3])<<16 | uint64(p[4])<<8 | uint64(p[5])}
+}
+
+// Uint64 returns the PackedUint48 as a uint64.
+func (p PackedUint48) Uint64() uint64 { return p.Unpack().Uint64() }
+
+// String returns the string representation of the PackedUint48.
+func (p PackedUint48) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedUint48.
+func (p PackedUint48) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedUint48.
+func (p *PackedUint48) UnmarshalJSON(data []byte) error {
+	var u Uint48
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = u.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedUint48.
+func (p PackedUint48) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedUint48.
+func (p *PackedUint48) UnmarshalBinary(data []byte) error {
+	if len(data) != 6 {
+		return ErrInt48InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
@@ -0,0 +1,204 @@
+package int48
+
+import (
+	"encoding/binary"
+	"iter"
+)
+
+// isLittleEndian reports whether order is little-endian; nil means big-endian.
+func isLittleEndian(order binary.ByteOrder) bool {
+	return order != nil && order.Uint16([]byte{1, 0}) == 1
+}
+
+func byteOrder(little bool) binary.ByteOrder {
+	if little {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// Int48Slice is a sequence of Int48 values stored back to back in a single
+// []byte, 6 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Int48Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewInt48Slice returns a Int48Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewInt48Slice(n int, order binary.ByteOrder) Int48Slice {
+	return Int48Slice{buf: make([]byte, n*6), little: isLittleEndian(order)}
+}
+
+// Int48SliceFromBytes returns a Int48Slice backed by b without copying.
+// len(b) must be a multiple of 6.
+func Int48SliceFromBytes(b []byte, order binary.ByteOrder) (Int48Slice, error) {
+	if len(b)%6 != 0 {
+		return Int48Slice{}, ErrInt48InvalidByteLength
+	}
+	return Int48Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Int48Slice) Len() int { return len(s.buf) / 6 }
+
+// Order returns the byte order of the slice.
+func (s Int48Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Int48Slice) At(i int) Int48 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt48IndexOutOfRange)
+	}
+	b := s.buf[i*6 : i*6+6]
+	if s.little {
+		v, _ := FromInt48LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromInt48Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Int48Slice) Set(i int, v Int48) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt48IndexOutOfRange
+	}
+	var b [6]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*6:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Int48Slice) Append(values ...Int48) Int48Slice {
+	if s.little {
+		s.buf = AppendInt48sLE(s.buf, values)
+	} else {
+		s.buf = AppendInt48sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Int48Slice) Slice(i, j int) Int48Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt48IndexOutOfRange)
+	}
+	return Int48Slice{buf: s.buf[i*6 : j*6], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Int48Slice) All() iter.Seq2[int, Int48] {
+	return func(yield func(int, Int48) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 6 bytes per value.
+func (s Int48Slice) Bytes() []byte { return s.buf }
+
+// Uint48Slice is a sequence of Uint48 values stored back to back in a single
+// []byte, 6 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Uint48Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewUint48Slice returns a Uint48Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewUint48Slice(n int, order binary.ByteOrder) Uint48Slice {
+	return Uint48Slice{buf: make([]byte, n*6), little: isLittleEndian(order)}
+}
+
+// Uint48SliceFromBytes returns a Uint48Slice backed by b without copying.
+// len(b) must be a multiple of 6.
+func Uint48SliceFromBytes(b []byte, order binary.ByteOrder) (Uint48Slice, error) {
+	if len(b)%6 != 0 {
+		return Uint48Slice{}, ErrInt48InvalidByteLength
+	}
+	return Uint48Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Uint48Slice) Len() int { return len(s.buf) / 6 }
+
+// Order returns the byte order of the slice.
+func (s Uint48Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Uint48Slice) At(i int) Uint48 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt48IndexOutOfRange)
+	}
+	b := s.buf[i*6 : i*6+6]
+	if s.little {
+		v, _ := FromUint48LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromUint48Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Uint48Slice) Set(i int, v Uint48) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt48IndexOutOfRange
+	}
+	var b [6]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*6:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Uint48Slice) Append(values ...Uint48) Uint48Slice {
+	if s.little {
+		s.buf = AppendUint48sLE(s.buf, values)
+	} else {
+		s.buf = AppendUint48sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Uint48Slice) Slice(i, j int) Uint48Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt48IndexOutOfRange)
+	}
+	return Uint48Slice{buf: s.buf[i*6 : j*6], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Uint48Slice) All() iter.Seq2[int, Uint48] {
+	return func(yield func(int, Uint48) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 6 bytes per value.
+func (s Uint48Slice) Bytes() []byte { return s.buf }
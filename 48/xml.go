@@ -0,0 +1,80 @@
+package int48
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// MarshalXML implements xml.Marshaler for Int48, encoding it as decimal
+// character data.
+func (i Int48) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Int48. Surrounding whitespace
+// is ignored, as encoding/xml does for built-in integers.
+func (i *Int48) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return i.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Int48.
+func (i Int48) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Int48.
+func (i *Int48) UnmarshalXMLAttr(attr xml.Attr) error { return i.setXML(attr.Value) }
+
+func (i *Int48) setXML(s string) error {
+	val, err := parseInt64(strings.TrimSpace(s), ErrInt48OutOfRange)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt48(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler for Uint48, encoding it as decimal
+// character data.
+func (u Uint48) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(u.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Uint48. Surrounding
+// whitespace is ignored, as encoding/xml does for built-in integers.
+func (u *Uint48) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return u.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Uint48.
+func (u Uint48) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: u.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Uint48.
+func (u *Uint48) UnmarshalXMLAttr(attr xml.Attr) error { return u.setXML(attr.Value) }
+
+func (u *Uint48) setXML(s string) error {
+	val, err := parseUint64(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint48(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
@@ -0,0 +1,194 @@
+package int56
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// EncodeUint56sBE writes the 7-byte big-endian form of each value in src to dst.
+// dst must hold at least 7*len(src) bytes.
+func EncodeUint56sBE(dst []byte, src []Uint56) error {
+	if len(dst) < len(src)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	encodeUint56sBE(dst[:len(src)*7], src)
+	return nil
+}
+
+// EncodeUint56sLE writes the 7-byte little-endian form of each value in src to dst.
+// dst must hold at least 7*len(src) bytes.
+func EncodeUint56sLE(dst []byte, src []Uint56) error {
+	if len(dst) < len(src)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	encodeUint56sLE(dst[:len(src)*7], src)
+	return nil
+}
+
+// EncodeInt56sBE writes the 7-byte big-endian form of each value in src to dst.
+// dst must hold at least 7*len(src) bytes.
+func EncodeInt56sBE(dst []byte, src []Int56) error {
+	if len(dst) < len(src)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	encodeInt56sBE(dst[:len(src)*7], src)
+	return nil
+}
+
+// EncodeInt56sLE writes the 7-byte little-endian form of each value in src to dst.
+// dst must hold at least 7*len(src) bytes.
+func EncodeInt56sLE(dst []byte, src []Int56) error {
+	if len(dst) < len(src)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	encodeInt56sLE(dst[:len(src)*7], src)
+	return nil
+}
+
+// AppendUint56sBE appends the 7-byte big-endian form of each value in src to dst.
+func AppendUint56sBE(dst []byte, src []Uint56) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*7)[:n+len(src)*7]
+	encodeUint56sBE(dst[n:], src)
+	return dst
+}
+
+// AppendUint56sLE appends the 7-byte little-endian form of each value in src to dst.
+func AppendUint56sLE(dst []byte, src []Uint56) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*7)[:n+len(src)*7]
+	encodeUint56sLE(dst[n:], src)
+	return dst
+}
+
+// AppendInt56sBE appends the 7-byte big-endian form of each value in src to dst.
+func AppendInt56sBE(dst []byte, src []Int56) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*7)[:n+len(src)*7]
+	encodeInt56sBE(dst[n:], src)
+	return dst
+}
+
+// AppendInt56sLE appends the 7-byte little-endian form of each value in src to dst.
+func AppendInt56sLE(dst []byte, src []Int56) []byte {
+	n := len(dst)
+	dst = slices.Grow(dst, len(src)*7)[:n+len(src)*7]
+	encodeInt56sLE(dst[n:], src)
+	return dst
+}
+
+// DecodeUint56sBE fills dst from the 7-byte big-endian values at the start of src.
+// src must hold at least 7*len(dst) bytes.
+func DecodeUint56sBE(dst []Uint56, src []byte) error {
+	if len(src) < len(dst)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	i, o := 0, 0
+	// Load a full word per value while one fits and drop the extra bytes.
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+7 {
+		dst[i].value = binary.BigEndian.Uint64(src[o:]) >> 8
+	}
+	for ; i < len(dst); i, o = i+1, o+7 {
+		dst[i], _ = FromUint56Bytes(src[o : o+7])
+	}
+	return nil
+}
+
+// DecodeUint56sLE fills dst from the 7-byte little-endian values at the start of src.
+// src must hold at least 7*len(dst) bytes.
+func DecodeUint56sLE(dst []Uint56, src []byte) error {
+	if len(src) < len(dst)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+7 {
+		dst[i].value = binary.LittleEndian.Uint64(src[o:]) & 0xFFFFFFFFFFFFFF
+	}
+	for ; i < len(dst); i, o = i+1, o+7 {
+		dst[i], _ = FromUint56LittleEndianBytes(src[o : o+7])
+	}
+	return nil
+}
+
+// DecodeInt56sBE fills dst from the 7-byte big-endian values at the start of src.
+// src must hold at least 7*len(dst) bytes.
+func DecodeInt56sBE(dst []Int56, src []byte) error {
+	if len(src) < len(dst)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	i, o := 0, 0
+	// The arithmetic shift sign-extends from bit 55.
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+7 {
+		dst[i].value = int64(binary.BigEndian.Uint64(src[o:])) >> 8
+	}
+	for ; i < len(dst); i, o = i+1, o+7 {
+		dst[i], _ = FromInt56Bytes(src[o : o+7])
+	}
+	return nil
+}
+
+// DecodeInt56sLE fills dst from the 7-byte little-endian values at the start of src.
+// src must hold at least 7*len(dst) bytes.
+func DecodeInt56sLE(dst []Int56, src []byte) error {
+	if len(src) < len(dst)*7 {
+		return ErrInt56InvalidByteLength
+	}
+	i, o := 0, 0
+	for ; i < len(dst) && o+8 <= len(src); i, o = i+1, o+7 {
+		dst[i].value = int64(binary.LittleEndian.Uint64(src[o:])<<8) >> 8
+	}
+	for ; i < len(dst); i, o = i+1, o+7 {
+		dst[i], _ = FromInt56LittleEndianBytes(src[o : o+7])
+	}
+	return nil
+}
+
+// encodeUint56sBE requires len(dst) == 7*len(src).
+func encodeUint56sBE(dst []byte, src []Uint56) {
+	i, o := 0, 0
+	// Store a full word per value while it stays inside dst; the extra
+	// bytes are overwritten by the next value.
+	for ; o+8 <= len(dst); i, o = i+1, o+7 {
+		binary.BigEndian.PutUint64(dst[o:], src[i].value<<8)
+	}
+	for ; i < len(src); i, o = i+1, o+7 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeUint56sLE requires len(dst) == 7*len(src).
+func encodeUint56sLE(dst []byte, src []Uint56) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+7 {
+		binary.LittleEndian.PutUint64(dst[o:], src[i].value)
+	}
+	for ; i < len(src); i, o = i+1, o+7 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt56sBE requires len(dst) == 7*len(src).
+func encodeInt56sBE(dst []byte, src []Int56) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+7 {
+		binary.BigEndian.PutUint64(dst[o:], uint64(src[i].value)<<8)
+	}
+	for ; i < len(src); i, o = i+1, o+7 {
+		b := src[i].ToBytes()
+		copy(dst[o:], b[:])
+	}
+}
+
+// encodeInt56sLE requires len(dst) == 7*len(src).
+func encodeInt56sLE(dst []byte, src []Int56) {
+	i, o := 0, 0
+	for ; o+8 <= len(dst); i, o = i+1, o+7 {
+		binary.LittleEndian.PutUint64(dst[o:], uint64(src[i].value))
+	}
+	for ; i < len(src); i, o = i+1, o+7 {
+		b := src[i].ToLittleEndianBytes()
+		copy(dst[o:], b[:])
+	}
+}
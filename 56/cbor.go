@@ -0,0 +1,110 @@
+package int56
+
+import "math"
+
+// MarshalCBOR encodes the Int56 as a CBOR (RFC 8949) integer, using major
+// type 0 or 1 with the shortest argument.
+func (i Int56) MarshalCBOR() ([]byte, error) {
+	v := i.Int64()
+	if v < 0 {
+		return appendCBORHead(nil, 1, uint64(-1-v)), nil
+	}
+	return appendCBORHead(nil, 0, uint64(v)), nil
+}
+
+// UnmarshalCBOR decodes a CBOR integer of major type 0 or 1 into the Int56.
+// Any argument width is accepted; values outside the Int56 range return
+// ErrInt56OutOfRange.
+func (i *Int56) UnmarshalCBOR(data []byte) error {
+	major, arg, err := readCBORInt(data)
+	if err != nil {
+		return err
+	}
+	if arg > math.MaxInt64 {
+		return ErrInt56OutOfRange
+	}
+	val := int64(arg)
+	if major == 1 {
+		val = -1 - val
+	}
+	newI, err := NewInt56(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalCBOR encodes the Uint56 as a CBOR (RFC 8949) unsigned integer,
+// using major type 0 with the shortest argument.
+func (u Uint56) MarshalCBOR() ([]byte, error) {
+	return appendCBORHead(nil, 0, u.Uint64()), nil
+}
+
+// UnmarshalCBOR decodes a CBOR integer of major type 0 or 1 into the Uint56.
+// Any argument width is accepted; negative values and values above the
+// Uint56 maximum return ErrUint56OutOfRange.
+func (u *Uint56) UnmarshalCBOR(data []byte) error {
+	major, arg, err := readCBORInt(data)
+	if err != nil {
+		return err
+	}
+	if major == 1 {
+		return ErrUint56OutOfRange
+	}
+	newU, err := NewUint56(arg)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
+
+// appendCBORHead appends a CBOR initial byte and argument in shortest form.
+func appendCBORHead(b []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(b, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return append(b, major|25, byte(arg>>8), byte(arg))
+	case arg <= math.MaxUint32:
+		return append(b, major|26, byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+	}
+	return append(b, major|27, byte(arg>>56), byte(arg>>48), byte(arg>>40), byte(arg>>32),
+		byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+}
+
+// readCBORInt decodes a complete CBOR integer data item, returning its major
+// type (0 or 1) and argument.
+func readCBORInt(data []byte) (byte, uint64, error) {
+	if len(data) == 0 {
+		return 0, 0, ErrInt56EmptyData
+	}
+	major, info := data[0]>>5, data[0]&0x1F
+	if major > 1 {
+		return 0, 0, ErrInt56InvalidCBOR
+	}
+	var n int
+	switch {
+	case info < 24:
+		n = 0
+	case info <= 27:
+		n = 1 << (info - 24)
+	default:
+		return 0, 0, ErrInt56InvalidCBOR
+	}
+	if len(data) != 1+n {
+		return 0, 0, ErrInt56InvalidCBOR
+	}
+	if n == 0 {
+		return major, uint64(info), nil
+	}
+	var arg uint64
+	for _, c := range data[1:] {
+		arg = arg<<8 | uint64(c)
+	}
+	return major, arg, nil
+}
@@ -13,6 +13,11 @@ var (
 	ErrUint56OutOfRange       = errors.New("value exceeds maximum for Uint56")
 	ErrInt56InvalidByteLength = errors.New("invalid byte length")
 	ErrInt56EmptyData         = errors.New("empty data")
+	ErrInt56IndexOutOfRange   = errors.New("index out of range")
+	ErrInt56ScanType          = errors.New("unsupported Scan source type")
+	ErrInt56NotIntegral       = errors.New("value is not an integer")
+	ErrInt56InvalidCBOR       = errors.New("invalid CBOR integer")
+	ErrInt56InvalidMsgpack    = errors.New("invalid MessagePack integer")
 )
 
 // Int56 represents a 56-bit signed integer stored in a 64-bit field.
@@ -0,0 +1,158 @@
+package int56
+
+import (
+	"database/sql/driver"
+	"strconv"
+)
+
+// NullInt56 represents an Int56 that may be null, like sql.NullInt64.
+type NullInt56 struct {
+	Int56 Int56
+	Valid bool // Valid is true if Int56 is not NULL
+}
+
+// Scan implements sql.Scanner for NullInt56.
+func (n *NullInt56) Scan(src any) error {
+	if src == nil {
+		n.Int56, n.Valid = Int56{}, false
+		return nil
+	}
+	if err := n.Int56.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullInt56.
+func (n NullInt56) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Int56.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullInt56.
+func (n NullInt56) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Int56.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullInt56.
+func (n *NullInt56) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Int56, n.Valid = Int56{}, false
+		return nil
+	}
+	if err := n.Int56.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullInt56.
+// A null value is marshaled as empty text.
+func (n NullInt56) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, n.Int56.Int64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullInt56.
+// Empty text is unmarshaled as null.
+func (n *NullInt56) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Int56, n.Valid = Int56{}, false
+		return nil
+	}
+	val, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt56(val)
+	if err != nil {
+		return err
+	}
+	n.Int56, n.Valid = newI, true
+	return nil
+}
+
+// NullUint56 represents a Uint56 that may be null, like sql.NullInt64.
+type NullUint56 struct {
+	Uint56 Uint56
+	Valid  bool // Valid is true if Uint56 is not NULL
+}
+
+// Scan implements sql.Scanner for NullUint56.
+func (n *NullUint56) Scan(src any) error {
+	if src == nil {
+		n.Uint56, n.Valid = Uint56{}, false
+		return nil
+	}
+	if err := n.Uint56.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer for NullUint56.
+func (n NullUint56) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Uint56.Value()
+}
+
+// MarshalJSON implements json.Marshaler for NullUint56.
+func (n NullUint56) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Uint56.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for NullUint56.
+func (n *NullUint56) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Uint56, n.Valid = Uint56{}, false
+		return nil
+	}
+	if err := n.Uint56.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for NullUint56.
+// A null value is marshaled as empty text.
+func (n NullUint56) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, n.Uint56.Uint64(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for NullUint56.
+// Empty text is unmarshaled as null.
+func (n *NullUint56) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Uint56, n.Valid = Uint56{}, false
+		return nil
+	}
+	val, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint56(val)
+	if err != nil {
+		return err
+	}
+	n.Uint56, n.Valid = newU, true
+	return nil
+}
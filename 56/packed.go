@@ -0,0 +1,93 @@
+package int56
+
+// PackedInt56 holds an Int56 in exactly 7 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Int56.Pack and
+// PackedInt56.Unpack for arithmetic.
+type PackedInt56 [7]byte
+
+// PackedUint56 holds a Uint56 in exactly 7 bytes, big-endian, so that
+// slices and arrays of it take no padding. Convert with Uint56.Pack and
+// PackedUint56.Unpack for arithmetic.
+type PackedUint56 [7]byte
+
+// Pack returns the packed 7-byte form of the Int56.
+func (i Int56) Pack() PackedInt56 { return PackedInt56(i.ToBytes()) }
+
+// Unpack returns the PackedInt56 as an Int56.
+func (p PackedInt56) Unpack() Int56 {
+	val := int64(p[0])<<48 | int64(p[1])<<40 | int64(p[2])<<32 | int64(p[3])<<24 | int64(p[4])<<16 | int64(p[5])<<8 | int64(p[6])
+	if p[0]&0x80 != 0 {
+		val |= ^0x7FFFFFFFFFFFFF
+	}
+	return Int56{value: val}
+}
+
+// Int64 returns the PackedInt56 as an int64.
+func (p PackedInt56) Int64() int64 { return p.Unpack().Int64() }
+
+// String returns the string representation of the PackedInt56.
+func (p PackedInt56) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedInt56.
+func (p PackedInt56) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedInt56.
+func (p *PackedInt56) UnmarshalJSON(data []byte) error {
+	var i Int56
+	if err := i.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = i.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedInt56.
+func (p PackedInt56) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedInt56.
+func (p *PackedInt56) UnmarshalBinary(data []byte) error {
+	if len(data) != 7 {
+		return ErrInt56InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
+
+// Pack returns the packed 7-byte form of the Uint56.
+func (u Uint56) Pack() PackedUint56 { return PackedUint56(u.ToBytes()) }
+
+// Unpack returns the PackedUint56 as a Uint56.
+func (p PackedUint56) Unpack() Uint56 {
+	return Uint56{value: uint64(p[0])<<48 | uint64(p[1])<<40 | uint64(p[2])<<32 | uint64(p[3])<<24 | uint64(p[4])<<16 | uint64(p[5])<<8 | uint64(p[6])}
+}
+
+// Uint64 returns the PackedUint56 as a uint64.
+func (p PackedUint56) Uint64() uint64 { return p.Unpack().Uint64() }
+
+// String returns the string representation of the PackedUint56.
+func (p PackedUint56) String() string { return p.Unpack().String() }
+
+// MarshalJSON implements json.Marshaler for PackedUint56.
+func (p PackedUint56) MarshalJSON() ([]byte, error) { return p.Unpack().MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler for PackedUint56.
+func (p *PackedUint56) UnmarshalJSON(data []byte) error {
+	var u Uint56
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = u.Pack()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for PackedUint56.
+func (p PackedUint56) MarshalBinary() ([]byte, error) { return p[:], nil }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PackedUint56.
+func (p *PackedUint56) UnmarshalBinary(data []byte) error {
+	if len(data) != 7 {
+		return ErrInt56InvalidByteLength
+	}
+	copy(p[:], data)
+	return nil
+}
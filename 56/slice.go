@@ -0,0 +1,204 @@
+package int56
+
+import (
+	"encoding/binary"
+	"iter"
+)
+
+// isLittleEndian reports whether order is little-endian; nil means big-endian.
+func isLittleEndian(order binary.ByteOrder) bool {
+	return order != nil && order.Uint16([]byte{1, 0}) == 1
+}
+
+func byteOrder(little bool) binary.ByteOrder {
+	if little {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// Int56Slice is a sequence of Int56 values stored back to back in a single
+// []byte, 7 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Int56Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewInt56Slice returns a Int56Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewInt56Slice(n int, order binary.ByteOrder) Int56Slice {
+	return Int56Slice{buf: make([]byte, n*7), little: isLittleEndian(order)}
+}
+
+// Int56SliceFromBytes returns a Int56Slice backed by b without copying.
+// len(b) must be a multiple of 7.
+func Int56SliceFromBytes(b []byte, order binary.ByteOrder) (Int56Slice, error) {
+	if len(b)%7 != 0 {
+		return Int56Slice{}, ErrInt56InvalidByteLength
+	}
+	return Int56Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Int56Slice) Len() int { return len(s.buf) / 7 }
+
+// Order returns the byte order of the slice.
+func (s Int56Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Int56Slice) At(i int) Int56 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt56IndexOutOfRange)
+	}
+	b := s.buf[i*7 : i*7+7]
+	if s.little {
+		v, _ := FromInt56LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromInt56Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Int56Slice) Set(i int, v Int56) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt56IndexOutOfRange
+	}
+	var b [7]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*7:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Int56Slice) Append(values ...Int56) Int56Slice {
+	if s.little {
+		s.buf = AppendInt56sLE(s.buf, values)
+	} else {
+		s.buf = AppendInt56sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Int56Slice) Slice(i, j int) Int56Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt56IndexOutOfRange)
+	}
+	return Int56Slice{buf: s.buf[i*7 : j*7], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Int56Slice) All() iter.Seq2[int, Int56] {
+	return func(yield func(int, Int56) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 7 bytes per value.
+func (s Int56Slice) Bytes() []byte { return s.buf }
+
+// Uint56Slice is a sequence of Uint56 values stored back to back in a single
+// []byte, 7 bytes per value, in a fixed byte order. Like a Go slice, it is a
+// view: Slice and copies share the underlying buffer.
+type Uint56Slice struct {
+	buf    []byte
+	little bool
+}
+
+// NewUint56Slice returns a Uint56Slice of n zero values in the given byte
+// order. A nil order means big-endian.
+func NewUint56Slice(n int, order binary.ByteOrder) Uint56Slice {
+	return Uint56Slice{buf: make([]byte, n*7), little: isLittleEndian(order)}
+}
+
+// Uint56SliceFromBytes returns a Uint56Slice backed by b without copying.
+// len(b) must be a multiple of 7.
+func Uint56SliceFromBytes(b []byte, order binary.ByteOrder) (Uint56Slice, error) {
+	if len(b)%7 != 0 {
+		return Uint56Slice{}, ErrInt56InvalidByteLength
+	}
+	return Uint56Slice{buf: b, little: isLittleEndian(order)}, nil
+}
+
+// Len returns the number of values in the slice.
+func (s Uint56Slice) Len() int { return len(s.buf) / 7 }
+
+// Order returns the byte order of the slice.
+func (s Uint56Slice) Order() binary.ByteOrder { return byteOrder(s.little) }
+
+// At returns the value at index i. It panics if i is out of range.
+func (s Uint56Slice) At(i int) Uint56 {
+	if i < 0 || i >= s.Len() {
+		panic(ErrInt56IndexOutOfRange)
+	}
+	b := s.buf[i*7 : i*7+7]
+	if s.little {
+		v, _ := FromUint56LittleEndianBytes(b)
+		return v
+	}
+	v, _ := FromUint56Bytes(b)
+	return v
+}
+
+// Set stores v at index i.
+func (s Uint56Slice) Set(i int, v Uint56) error {
+	if i < 0 || i >= s.Len() {
+		return ErrInt56IndexOutOfRange
+	}
+	var b [7]byte
+	if s.little {
+		b = v.ToLittleEndianBytes()
+	} else {
+		b = v.ToBytes()
+	}
+	copy(s.buf[i*7:], b[:])
+	return nil
+}
+
+// Append appends values and returns the updated slice, like the built-in append.
+func (s Uint56Slice) Append(values ...Uint56) Uint56Slice {
+	if s.little {
+		s.buf = AppendUint56sLE(s.buf, values)
+	} else {
+		s.buf = AppendUint56sBE(s.buf, values)
+	}
+	return s
+}
+
+// Slice returns the values from index i up to but not including j, sharing
+// the underlying buffer. It panics if the indexes are out of range.
+func (s Uint56Slice) Slice(i, j int) Uint56Slice {
+	// Check against Len explicitly: slicing buf alone would allow j up to
+	// its capacity and expose values past the end of a resliced view.
+	if i < 0 || i > j || j > s.Len() {
+		panic(ErrInt56IndexOutOfRange)
+	}
+	return Uint56Slice{buf: s.buf[i*7 : j*7], little: s.little}
+}
+
+// All returns an iterator over the indexes and values of the slice.
+func (s Uint56Slice) All() iter.Seq2[int, Uint56] {
+	return func(yield func(int, Uint56) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Bytes returns the underlying buffer, 7 bytes per value.
+func (s Uint56Slice) Bytes() []byte { return s.buf }
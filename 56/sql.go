@@ -0,0 +1,120 @@
+package int56
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// Scan implements sql.Scanner for Int56. It accepts int64, uint64, float64
+// with an integral value, and decimal []byte or string.
+func (i *Int56) Scan(src any) error {
+	val, err := scanInt64(src, ErrInt56OutOfRange)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt56(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// Value implements driver.Valuer for Int56.
+func (i Int56) Value() (driver.Value, error) { return i.Int64(), nil }
+
+// Scan implements sql.Scanner for Uint56. It accepts int64, uint64, float64
+// with an integral value, and decimal []byte or string.
+func (u *Uint56) Scan(src any) error {
+	val, err := scanUint64(src)
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint56(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
+
+// Value implements driver.Valuer for Uint56. Every Uint56 fits in an int64,
+// the integer type drivers must accept.
+func (u Uint56) Value() (driver.Value, error) { return int64(u.Uint64()), nil }
+
+// scanInt64 converts a database value to an int64, reporting values outside
+// the int64 range as outOfRange.
+func scanInt64(src any, outOfRange error) (int64, error) {
+	switch v := src.(type) {
+	case int64:
+		return v, nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, outOfRange
+		}
+		return int64(v), nil
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return 0, ErrInt56NotIntegral
+		}
+		if v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, outOfRange
+		}
+		return int64(v), nil
+	case []byte:
+		return parseInt64(string(v), outOfRange)
+	case string:
+		return parseInt64(v, outOfRange)
+	}
+	return 0, ErrInt56ScanType
+}
+
+func parseInt64(s string, outOfRange error) (int64, error) {
+	val, err := strconv.ParseInt(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, outOfRange
+	}
+	return val, err
+}
+
+// scanUint64 converts a database value to a uint64.
+func scanUint64(src any) (uint64, error) {
+	switch v := src.(type) {
+	case int64:
+		if v < 0 {
+			return 0, ErrUint56OutOfRange
+		}
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return 0, ErrInt56NotIntegral
+		}
+		if v < 0 || v >= math.MaxUint64 {
+			return 0, ErrUint56OutOfRange
+		}
+		return uint64(v), nil
+	case []byte:
+		return parseUint64(string(v))
+	case string:
+		return parseUint64(v)
+	}
+	return 0, ErrInt56ScanType
+}
+
+func parseUint64(s string) (uint64, error) {
+	val, err := strconv.ParseUint(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrUint56OutOfRange
+	}
+	if err != nil {
+		// A well-formed negative number is out of range, not a syntax error.
+		if neg, nerr := strconv.ParseInt(s, 10, 64); (nerr == nil && neg < 0) || errors.Is(nerr, strconv.ErrRange) {
+			return 0, ErrUint56OutOfRange
+		}
+	}
+	return val, err
+}
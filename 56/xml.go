@@ -0,0 +1,80 @@
+package int56
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// MarshalXML implements xml.Marshaler for Int56, encoding it as decimal
+// character data.
+func (i Int56) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Int56. Surrounding whitespace
+// is ignored, as encoding/xml does for built-in integers.
+func (i *Int56) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return i.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Int56.
+func (i Int56) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Int56.
+func (i *Int56) UnmarshalXMLAttr(attr xml.Attr) error { return i.setXML(attr.Value) }
+
+func (i *Int56) setXML(s string) error {
+	val, err := parseInt64(strings.TrimSpace(s), ErrInt56OutOfRange)
+	if err != nil {
+		return err
+	}
+	newI, err := NewInt56(val)
+	if err != nil {
+		return err
+	}
+	*i = newI
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler for Uint56, encoding it as decimal
+// character data.
+func (u Uint56) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(u.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Uint56. Surrounding
+// whitespace is ignored, as encoding/xml does for built-in integers.
+func (u *Uint56) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return u.setXML(s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for Uint56.
+func (u Uint56) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: u.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Uint56.
+func (u *Uint56) UnmarshalXMLAttr(attr xml.Attr) error { return u.setXML(attr.Value) }
+
+func (u *Uint56) setXML(s string) error {
+	val, err := parseUint64(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	newU, err := NewUint56(val)
+	if err != nil {
+		return err
+	}
+	*u = newU
+	return nil
+}
@@ -0,0 +1,197 @@
+// Package bitpack implements frame-of-reference bit-packing for columns of
+// intx values. Values are grouped into blocks; each block stores its minimum
+// as a base and every value as an offset from the base using the fewest
+// bits that fit the block's range.
+//
+// A stream is a sequence of blocks, each encoded as:
+//
+//	uvarint value count (1 to MaxBlockSize)
+//	zigzag varint base
+//	byte    bit width (0 to 56)
+//	ceil(count*width/8) bytes of offsets packed LSB-first
+package bitpack
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"slices"
+
+	"github.com/CVDpl/go-intx/internal/conv"
+)
+
+// Common errors for the bitpack package
+var (
+	ErrCorrupt = errors.New("corrupt bitpack stream")
+	ErrClosed  = errors.New("bitpack writer is closed")
+)
+
+const (
+	// BlockSize is the number of values per block written by Encode and Writer.
+	BlockSize = 128
+	// MaxBlockSize is the largest block count accepted when decoding.
+	MaxBlockSize = 1 << 16
+	// maxWidth is the widest possible offset: the full range of a 56-bit type.
+	maxWidth = 56
+)
+
+// Value is the set of types that can be bit-packed.
+type Value = conv.Value
+
+// Encode bit-packs values in blocks of BlockSize.
+func Encode[T Value](values []T) []byte {
+	c := conv.For[T]()
+	var out []byte
+	scratch := make([]int64, 0, BlockSize)
+	for len(values) > 0 {
+		n := min(len(values), BlockSize)
+		scratch = scratch[:0]
+		for _, v := range values[:n] {
+			scratch = append(scratch, c.ToInt(v))
+		}
+		out = appendBlock(out, scratch)
+		values = values[n:]
+	}
+	return out
+}
+
+// Decode unpacks a stream produced by Encode or Writer. Decoded values are
+// range-checked against T.
+func Decode[T Value](data []byte) ([]T, error) {
+	total := 0
+	for rest := data; len(rest) > 0; {
+		b, n, err := parseBlock(rest)
+		if err != nil {
+			return nil, err
+		}
+		total += b.count
+		rest = rest[n:]
+	}
+	c := conv.For[T]()
+	lo, hi := conv.Bounds[T]()
+	out := make([]T, 0, total)
+	for len(data) > 0 {
+		b, n, _ := parseBlock(data) // validated by the counting pass
+		var err error
+		if out, err = appendValues(out, b, lo, hi, c); err != nil {
+			return nil, err
+		}
+		data = data[n:]
+	}
+	return out, nil
+}
+
+// appendBlock encodes one block of values.
+func appendBlock(dst []byte, values []int64) []byte {
+	base, hi := values[0], values[0]
+	for _, x := range values[1:] {
+		base = min(base, x)
+		hi = max(hi, x)
+	}
+	width := bits.Len64(uint64(hi - base))
+
+	dst = binary.AppendUvarint(dst, uint64(len(values)))
+	dst = binary.AppendVarint(dst, base)
+	dst = append(dst, byte(width))
+
+	// acc never holds more than 7 pending bits before an offset of at most
+	// 56 bits is added, so it cannot overflow.
+	var acc uint64
+	var nacc int
+	for _, x := range values {
+		acc |= uint64(x-base) << nacc
+		nacc += width
+		for nacc >= 8 {
+			dst = append(dst, byte(acc))
+			acc >>= 8
+			nacc -= 8
+		}
+	}
+	if nacc > 0 {
+		dst = append(dst, byte(acc))
+	}
+	return dst
+}
+
+// block is a parsed block whose offsets are still packed.
+type block struct {
+	count  int
+	base   int64
+	width  int
+	packed []byte // ceil(count*width/8) bytes, aliasing the input
+}
+
+// parseBlock parses the block at the start of data and returns it with its
+// encoded length.
+func parseBlock(data []byte) (block, int, error) {
+	count, n := binary.Uvarint(data)
+	if n <= 0 || count == 0 || count > MaxBlockSize {
+		return block{}, 0, ErrCorrupt
+	}
+	pos := n
+	base, n := binary.Varint(data[pos:])
+	if n <= 0 {
+		return block{}, 0, ErrCorrupt
+	}
+	pos += n
+	if pos >= len(data) || data[pos] > maxWidth {
+		return block{}, 0, ErrCorrupt
+	}
+	width := int(data[pos])
+	pos++
+	size := (int(count)*width + 7) / 8
+	if len(data)-pos < size {
+		return block{}, 0, ErrCorrupt
+	}
+	b := block{count: int(count), base: base, width: width, packed: data[pos : pos+size]}
+	return b, pos + size, nil
+}
+
+// offset returns the i-th packed offset. Offsets whose 8-byte window lies
+// inside packed are read with a single unaligned load; the last few are
+// assembled from the remaining bytes.
+func (b *block) offset(i int, mask uint64) uint64 {
+	bit := i * b.width
+	p := b.packed[bit>>3:]
+	var w uint64
+	if len(p) >= 8 {
+		w = binary.LittleEndian.Uint64(p)
+	} else {
+		for j := len(p) - 1; j >= 0; j-- {
+			w = w<<8 | uint64(p[j])
+		}
+	}
+	return w >> (bit & 7) & mask
+}
+
+// appendValues appends the values of b to dst. The whole block is checked
+// against T's bounds [lo, hi] once, from its base and bit width, and then
+// converted without per-value checks. A block whose width alone reaches
+// past hi may still hold only valid values, so it falls back to checking
+// each one; on a range error the values before it are returned as well.
+func appendValues[T Value](dst []T, b block, lo, hi int64, c conv.Converter[T]) ([]T, error) {
+	mask := uint64(1)<<b.width - 1
+	if b.base >= lo && b.base <= hi && uint64(hi-b.base) >= mask {
+		start := len(dst)
+		dst = slices.Grow(dst, b.count)[:start+b.count]
+		out := dst[start:]
+		// Read offsets in place while their 8-byte window fits in packed.
+		i, bit := 0, 0
+		for ; i < len(out) && bit>>3+8 <= len(b.packed); i, bit = i+1, bit+b.width {
+			off := binary.LittleEndian.Uint64(b.packed[bit>>3:]) >> (bit & 7) & mask
+			out[i] = conv.Unchecked[T](b.base + int64(off))
+		}
+		for ; i < len(out); i++ {
+			out[i] = conv.Unchecked[T](b.base + int64(b.offset(i, mask)))
+		}
+		return dst, nil
+	}
+	for i := range b.count {
+		v, err := c.FromInt(b.base + int64(b.offset(i, mask)))
+		if err != nil {
+			return dst, err
+		}
+		dst = append(dst, v)
+	}
+	return dst, nil
+}
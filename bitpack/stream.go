@@ -0,0 +1,158 @@
+package bitpack
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/CVDpl/go-intx/internal/conv"
+)
+
+// Writer bit-packs values to an io.Writer one block at a time.
+type Writer[T Value] struct {
+	w       io.Writer
+	conv    conv.Converter[T]
+	pending []int64
+	buf     []byte
+	closed  bool
+}
+
+// NewWriter returns a Writer that writes blocks to w.
+func NewWriter[T Value](w io.Writer) *Writer[T] {
+	return &Writer[T]{w: w, conv: conv.For[T](), pending: make([]int64, 0, BlockSize)}
+}
+
+// Write adds values to the stream, writing each block as it fills up.
+func (w *Writer[T]) Write(values ...T) error {
+	if w.closed {
+		return ErrClosed
+	}
+	for _, v := range values {
+		w.pending = append(w.pending, w.conv.ToInt(v))
+		if len(w.pending) == BlockSize {
+			if err := w.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close writes the final, possibly partial, block. It does not close the
+// underlying writer.
+func (w *Writer[T]) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+	if len(w.pending) == 0 {
+		return nil
+	}
+	return w.flush()
+}
+
+func (w *Writer[T]) flush() error {
+	w.buf = appendBlock(w.buf[:0], w.pending)
+	w.pending = w.pending[:0]
+	_, err := w.w.Write(w.buf)
+	return err
+}
+
+// Reader unpacks values from an io.Reader one block at a time.
+type Reader[T Value] struct {
+	r       *bufio.Reader
+	conv    conv.Converter[T]
+	lo, hi  int64
+	block   []byte
+	values  []T   // decoded values of the current block
+	pending []T   // the part of values not yet returned
+	err     error // range error to report once pending is drained
+}
+
+// NewReader returns a Reader that reads blocks from r. It buffers r and may
+// read past the end of the stream.
+func NewReader[T Value](r io.Reader) *Reader[T] {
+	lo, hi := conv.Bounds[T]()
+	return &Reader[T]{r: bufio.NewReader(r), conv: conv.For[T](), lo: lo, hi: hi}
+}
+
+// Read decodes up to len(dst) values into dst and returns the number read.
+// It returns io.EOF at the end of the stream and ErrCorrupt for a
+// truncated or malformed block.
+func (r *Reader[T]) Read(dst []T) (int, error) {
+	n := 0
+	for n < len(dst) {
+		if len(r.pending) == 0 {
+			if err := r.next(); err != nil {
+				if err == io.EOF && n > 0 {
+					return n, nil
+				}
+				return n, err
+			}
+		}
+		m := copy(dst[n:], r.pending)
+		n += m
+		r.pending = r.pending[m:]
+	}
+	return n, nil
+}
+
+// ReadAll reads the remaining values of the stream.
+func (r *Reader[T]) ReadAll() ([]T, error) {
+	var out []T
+	for {
+		if err := r.next(); err != nil {
+			if err == io.EOF {
+				return out, nil
+			}
+			return nil, err
+		}
+		out = append(out, r.pending...)
+		r.pending = nil
+	}
+}
+
+// next reads and decodes the next block into pending. If the block holds
+// an out-of-range value, pending gets the values before it and the error
+// is returned by the following call.
+func (r *Reader[T]) next() error {
+	if r.err != nil {
+		err := r.err
+		r.err = nil
+		return err
+	}
+	if _, err := r.r.Peek(1); err != nil {
+		return err
+	}
+	// The header is at most two varints and a width byte.
+	header, _ := r.r.Peek(2*binary.MaxVarintLen64 + 1)
+	count, n := binary.Uvarint(header)
+	if n <= 0 || count == 0 || count > MaxBlockSize {
+		return ErrCorrupt
+	}
+	_, m := binary.Varint(header[n:])
+	if m <= 0 || n+m >= len(header) || header[n+m] > maxWidth {
+		return ErrCorrupt
+	}
+	size := n + m + 1 + (int(count)*int(header[n+m])+7)/8
+
+	if cap(r.block) < size {
+		r.block = make([]byte, size)
+	}
+	r.block = r.block[:size]
+	if _, err := io.ReadFull(r.r, r.block); err != nil {
+		return ErrCorrupt
+	}
+	b, _, err := parseBlock(r.block)
+	if err != nil {
+		return err
+	}
+	r.values, r.err = appendValues(r.values[:0], b, r.lo, r.hi, r.conv)
+	r.pending = r.values
+	if len(r.pending) == 0 && r.err != nil {
+		err := r.err
+		r.err = nil
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package intx
+
+import (
+	"bytes"
+	"io"
+	"math/rand/v2"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/bitpack"
+
+	"testing"
+)
+
+func TestBitpackRoundTrip(t *testing.T) {
+	values := make([]Uint40, 1000)
+	for i := range values {
+		values[i] = MustUint40(0x8000000000 + rand.Uint64N(1000))
+	}
+	data := bitpack.Encode(values)
+	// 10 bits per value plus a few bytes of header per block of 128.
+	if len(data) > 1000*10/8+8*12 {
+		t.Errorf("Encode() produced %d bytes for 1000 values with a range of 1000", len(data))
+	}
+	got, err := bitpack.Decode[Uint40](data)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if len(got) != len(values) {
+		t.Fatalf("Decode() returned %d values, want %d", len(got), len(values))
+	}
+	for i := range values {
+		if got[i] != values[i] {
+			t.Fatalf("Decode()[%d] = %v, want %v", i, got[i], values[i])
+		}
+	}
+}
+
+func TestBitpackExtremes(t *testing.T) {
+	signed := []Int56{MustInt56(-0x80000000000000), MustInt56(0x7FFFFFFFFFFFFF), MustInt56(0), MustInt56(-1)}
+	got, err := bitpack.Decode[Int56](bitpack.Encode(signed))
+	if err != nil || len(got) != len(signed) {
+		t.Fatalf("Decode() = %v, %v", got, err)
+	}
+	for i := range signed {
+		if got[i] != signed[i] {
+			t.Errorf("Decode()[%d] = %v, want %v", i, got[i], signed[i])
+		}
+	}
+
+	constant := []Uint24{MustUint24(7), MustUint24(7), MustUint24(7)}
+	data := bitpack.Encode(constant)
+	if len(data) != 3 {
+		t.Errorf("Encode(constant) = %x, want a 3-byte header with zero width", data)
+	}
+	if got, err := bitpack.Decode[Uint24](data); err != nil || len(got) != 3 || got[2] != constant[2] {
+		t.Errorf("Decode(constant) = %v, %v", got, err)
+	}
+
+	if _, err := bitpack.Decode[Int24](bitpack.Encode([]Uint40{MustUint40(0x800000)})); err != ErrInt24OutOfRange {
+		t.Errorf("Decode[Int24]() error = %v, want %v", err, ErrInt24OutOfRange)
+	}
+	data = bitpack.Encode([]Uint40{MustUint40(1), MustUint40(1000)})
+	if _, err := bitpack.Decode[Uint40](data[:len(data)-1]); err != bitpack.ErrCorrupt {
+		t.Errorf("Decode(truncated) error = %v, want %v", err, bitpack.ErrCorrupt)
+	}
+}
+
+// TestBitpackBlockRangeFallback covers blocks whose bit width alone reaches
+// past the type's maximum even though every value fits.
+func TestBitpackBlockRangeFallback(t *testing.T) {
+	values := []Uint24{MustUint24(1), MustUint24(0xFFFFFF), MustUint24(0x800000)}
+	got, err := bitpack.Decode[Uint24](bitpack.Encode(values))
+	if err != nil || len(got) != len(values) {
+		t.Fatalf("Decode() = %v, %v", got, err)
+	}
+	for i := range values {
+		if got[i] != values[i] {
+			t.Errorf("Decode()[%d] = %v, want %v", i, got[i], values[i])
+		}
+	}
+
+	// The Reader returns the values before an out-of-range one, then the error.
+	wide := []Uint40{MustUint40(1), MustUint40(2), MustUint40(0x1000000), MustUint40(3)}
+	r := bitpack.NewReader[Uint24](bytes.NewReader(bitpack.Encode(wide)))
+	buf := make([]Uint24, 4)
+	n, err := r.Read(buf)
+	if n != 2 || buf[0] != MustUint24(1) || buf[1] != MustUint24(2) {
+		t.Errorf("Read() = %d, %v, want the 2 values before the bad one", n, buf[:n])
+	}
+	if err == nil {
+		_, err = r.Read(buf)
+	}
+	if err != ErrUint24OutOfRange {
+		t.Errorf("Read() error = %v, want %v", err, ErrUint24OutOfRange)
+	}
+	if _, err := bitpack.NewReader[Uint24](bytes.NewReader(bitpack.Encode(wide))).ReadAll(); err != ErrUint24OutOfRange {
+		t.Errorf("ReadAll() error = %v, want %v", err, ErrUint24OutOfRange)
+	}
+}
+
+func TestBitpackStreaming(t *testing.T) {
+	var buf bytes.Buffer
+	w := bitpack.NewWriter[Int24](&buf)
+	for i := 0; i < 300; i++ {
+		if err := w.Write(MustInt24(int64(i*i) - 40000)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(MustInt24(0)); err != bitpack.ErrClosed {
+		t.Errorf("Write() after Close error = %v, want %v", err, bitpack.ErrClosed)
+	}
+
+	inMemory, err := bitpack.Decode[Int24](buf.Bytes())
+	if err != nil || len(inMemory) != 300 {
+		t.Fatalf("Decode() = %d values, %v", len(inMemory), err)
+	}
+
+	r := bitpack.NewReader[Int24](bytes.NewReader(buf.Bytes()))
+	chunk := make([]Int24, 77)
+	var got []Int24
+	for {
+		n, err := r.Read(chunk)
+		got = append(got, chunk[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != 300 {
+		t.Fatalf("Read() returned %d values, want 300", len(got))
+	}
+	for i, v := range got {
+		if v.Int64() != int64(i*i)-40000 || v != inMemory[i] {
+			t.Fatalf("Read()[%d] = %v, want %d", i, v, i*i-40000)
+		}
+	}
+
+	all, err := bitpack.NewReader[Int24](bytes.NewReader(buf.Bytes()[:buf.Len()-1])).ReadAll()
+	if err != bitpack.ErrCorrupt {
+		t.Errorf("ReadAll(truncated) = %d values, %v, want %v", len(all), err, bitpack.ErrCorrupt)
+	}
+}
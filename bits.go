@@ -0,0 +1,266 @@
+package intx
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Common errors for BitReader and BitWriter
+var (
+	ErrInvalidBitCount  = errors.New("bit count must be between 0 and 64")
+	ErrBitValueOverflow = errors.New("value does not fit in bit count")
+)
+
+// BitOrder selects how bits are taken from, and packed into, each byte.
+type BitOrder int
+
+const (
+	// MSBFirst fills each byte from its most significant bit, and a value's
+	// most significant bit comes first (MPEG, ADS-B).
+	MSBFirst BitOrder = iota
+	// LSBFirst fills each byte from its least significant bit, and a value's
+	// least significant bit comes first (DEFLATE, CAN little-endian signals).
+	LSBFirst
+)
+
+// BitReader reads values that are not aligned to byte boundaries.
+type BitReader struct {
+	r     io.ByteReader
+	order BitOrder
+	cur   byte
+	nbits uint // unread bits left in cur
+}
+
+// NewBitReader returns a BitReader reading from r. If r does not implement
+// io.ByteReader it is wrapped in a bufio.Reader, which may read ahead.
+func NewBitReader(r io.Reader, order BitOrder) *BitReader {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &BitReader{r: br, order: order}
+}
+
+// NewBitReaderBytes returns a BitReader reading from b.
+func NewBitReaderBytes(b []byte, order BitOrder) *BitReader {
+	return &BitReader{r: bytes.NewReader(b), order: order}
+}
+
+// ReadBits reads an n-bit unsigned value, 0 <= n <= 64. It returns io.EOF if
+// no bits were left and io.ErrUnexpectedEOF if the input ended mid-value.
+func (br *BitReader) ReadBits(n uint) (uint64, error) {
+	if n > 64 {
+		return 0, ErrInvalidBitCount
+	}
+	var v uint64
+	var got uint
+	for got < n {
+		if br.nbits == 0 {
+			b, err := br.r.ReadByte()
+			if err != nil {
+				if err == io.EOF && got > 0 {
+					err = io.ErrUnexpectedEOF
+				}
+				return 0, err
+			}
+			br.cur, br.nbits = b, 8
+		}
+		k := min(n-got, br.nbits)
+		mask := byte(1)<<k - 1
+		if br.order == MSBFirst {
+			v = v<<k | uint64(br.cur>>(br.nbits-k)&mask)
+		} else {
+			v |= uint64(br.cur>>(8-br.nbits)&mask) << got
+		}
+		br.nbits -= k
+		got += k
+	}
+	return v, nil
+}
+
+// readSigned reads an n-bit two's complement value and sign-extends it.
+func (br *BitReader) readSigned(n uint) (int64, error) {
+	v, err := br.ReadBits(n)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v<<(64-n)) >> (64 - n), nil
+}
+
+// Align discards the remaining bits of the current byte.
+func (br *BitReader) Align() { br.nbits = 0 }
+
+// ReadUint24 reads a 24-bit unsigned value.
+func (br *BitReader) ReadUint24() (int24.Uint24, error) {
+	v, err := br.ReadBits(24)
+	if err != nil {
+		return int24.Uint24{}, err
+	}
+	return int24.NewUint24(v)
+}
+
+// ReadInt24 reads a 24-bit two's complement value.
+func (br *BitReader) ReadInt24() (int24.Int24, error) {
+	v, err := br.readSigned(24)
+	if err != nil {
+		return int24.Int24{}, err
+	}
+	return int24.NewInt24(v)
+}
+
+// ReadUint40 reads a 40-bit unsigned value.
+func (br *BitReader) ReadUint40() (int40.Uint40, error) {
+	v, err := br.ReadBits(40)
+	if err != nil {
+		return int40.Uint40{}, err
+	}
+	return int40.NewUint40(v)
+}
+
+// ReadInt40 reads a 40-bit two's complement value.
+func (br *BitReader) ReadInt40() (int40.Int40, error) {
+	v, err := br.readSigned(40)
+	if err != nil {
+		return int40.Int40{}, err
+	}
+	return int40.NewInt40(v)
+}
+
+// ReadUint48 reads a 48-bit unsigned value.
+func (br *BitReader) ReadUint48() (int48.Uint48, error) {
+	v, err := br.ReadBits(48)
+	if err != nil {
+		return int48.Uint48{}, err
+	}
+	return int48.NewUint48(v)
+}
+
+// ReadInt48 reads a 48-bit two's complement value.
+func (br *BitReader) ReadInt48() (int48.Int48, error) {
+	v, err := br.readSigned(48)
+	if err != nil {
+		return int48.Int48{}, err
+	}
+	return int48.NewInt48(v)
+}
+
+// ReadUint56 reads a 56-bit unsigned value.
+func (br *BitReader) ReadUint56() (int56.Uint56, error) {
+	v, err := br.ReadBits(56)
+	if err != nil {
+		return int56.Uint56{}, err
+	}
+	return int56.NewUint56(v)
+}
+
+// ReadInt56 reads a 56-bit two's complement value.
+func (br *BitReader) ReadInt56() (int56.Int56, error) {
+	v, err := br.readSigned(56)
+	if err != nil {
+		return int56.Int56{}, err
+	}
+	return int56.NewInt56(v)
+}
+
+// BitWriter writes values that are not aligned to byte boundaries. Partial
+// bytes are held until they fill up or Flush is called.
+type BitWriter struct {
+	w     *bufio.Writer
+	order BitOrder
+	cur   byte
+	nbits uint // bits already used in cur
+}
+
+// NewBitWriter returns a BitWriter writing to w.
+func NewBitWriter(w io.Writer, order BitOrder) *BitWriter {
+	return &BitWriter{w: bufio.NewWriter(w), order: order}
+}
+
+// WriteBits writes the low n bits of v, 0 <= n <= 64. It returns
+// ErrBitValueOverflow if v does not fit in n bits.
+func (bw *BitWriter) WriteBits(v uint64, n uint) error {
+	if n > 64 {
+		return ErrInvalidBitCount
+	}
+	if n < 64 && v>>n != 0 {
+		return ErrBitValueOverflow
+	}
+	return bw.writeBits(v, n)
+}
+
+func (bw *BitWriter) writeBits(v uint64, n uint) error {
+	for n > 0 {
+		k := min(n, 8-bw.nbits)
+		mask := uint64(1)<<k - 1
+		if bw.order == MSBFirst {
+			bw.cur |= byte(v>>(n-k)&mask) << (8 - bw.nbits - k)
+		} else {
+			bw.cur |= byte(v&mask) << bw.nbits
+			v >>= k
+		}
+		bw.nbits += k
+		n -= k
+		if bw.nbits == 8 {
+			if err := bw.w.WriteByte(bw.cur); err != nil {
+				return err
+			}
+			bw.cur, bw.nbits = 0, 0
+		}
+	}
+	return nil
+}
+
+// Align pads the current byte with zero bits.
+func (bw *BitWriter) Align() error {
+	if bw.nbits == 0 {
+		return nil
+	}
+	return bw.writeBits(0, 8-bw.nbits)
+}
+
+// Flush pads the current byte with zero bits and writes all buffered data.
+func (bw *BitWriter) Flush() error {
+	if err := bw.Align(); err != nil {
+		return err
+	}
+	return bw.w.Flush()
+}
+
+// WriteUint24 writes v as a 24-bit field.
+func (bw *BitWriter) WriteUint24(v int24.Uint24) error { return bw.writeBits(v.Uint64(), 24) }
+
+// WriteInt24 writes v as a 24-bit two's complement field.
+func (bw *BitWriter) WriteInt24(v int24.Int24) error {
+	return bw.writeBits(uint64(v.Int64())&(1<<24-1), 24)
+}
+
+// WriteUint40 writes v as a 40-bit field.
+func (bw *BitWriter) WriteUint40(v int40.Uint40) error { return bw.writeBits(v.Uint64(), 40) }
+
+// WriteInt40 writes v as a 40-bit two's complement field.
+func (bw *BitWriter) WriteInt40(v int40.Int40) error {
+	return bw.writeBits(uint64(v.Int64())&(1<<40-1), 40)
+}
+
+// WriteUint48 writes v as a 48-bit field.
+func (bw *BitWriter) WriteUint48(v int48.Uint48) error { return bw.writeBits(v.Uint64(), 48) }
+
+// WriteInt48 writes v as a 48-bit two's complement field.
+func (bw *BitWriter) WriteInt48(v int48.Int48) error {
+	return bw.writeBits(uint64(v.Int64())&(1<<48-1), 48)
+}
+
+// WriteUint56 writes v as a 56-bit field.
+func (bw *BitWriter) WriteUint56(v int56.Uint56) error { return bw.writeBits(v.Uint64(), 56) }
+
+// WriteInt56 writes v as a 56-bit two's complement field.
+func (bw *BitWriter) WriteInt56(v int56.Int56) error {
+	return bw.writeBits(uint64(v.Int64())&(1<<56-1), 56)
+}
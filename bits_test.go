@@ -0,0 +1,99 @@
+package intx
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+func TestBitReaderMSBFirst(t *testing.T) {
+	// 3 bits 101, then Int24 -2 (0xFFFFFE), then 5 bits 10011
+	data := []byte{0xBF, 0xFF, 0xFF, 0xD3}
+	br := NewBitReaderBytes(data, MSBFirst)
+
+	if v, err := br.ReadBits(3); err != nil || v != 0b101 {
+		t.Fatalf("ReadBits(3) = %v, %v, want 5", v, err)
+	}
+	i24, err := br.ReadInt24()
+	if err != nil || i24.Int64() != -2 {
+		t.Fatalf("ReadInt24() = %v, %v, want -2", i24, err)
+	}
+	if v, err := br.ReadBits(5); err != nil || v != 0b10011 {
+		t.Fatalf("ReadBits(5) = %v, %v, want 19", v, err)
+	}
+	if _, err := br.ReadBits(1); err != io.EOF {
+		t.Errorf("ReadBits() at end error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestBitReaderLSBFirst(t *testing.T) {
+	// 4 bits 0x5, then Uint24 0xABCDEF, then 4 bits 0x9
+	data := []byte{0xF5, 0xDE, 0xBC, 0x9A}
+	br := NewBitReader(bytes.NewBuffer(data), LSBFirst)
+
+	if v, err := br.ReadBits(4); err != nil || v != 0x5 {
+		t.Fatalf("ReadBits(4) = %v, %v, want 5", v, err)
+	}
+	u24, err := br.ReadUint24()
+	if err != nil || u24.Uint64() != 0xABCDEF {
+		t.Fatalf("ReadUint24() = %x, %v, want abcdef", u24.Uint64(), err)
+	}
+	if _, err := br.ReadBits(8); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadBits() past end error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestBitWriterRoundTrip(t *testing.T) {
+	for _, order := range []BitOrder{MSBFirst, LSBFirst} {
+		var buf bytes.Buffer
+		bw := NewBitWriter(&buf, order)
+		if err := bw.WriteBits(0b101, 3); err != nil {
+			t.Fatal(err)
+		}
+		if err := bw.WriteInt40(MustInt40(-123456789)); err != nil {
+			t.Fatal(err)
+		}
+		if err := bw.WriteUint56(MustUint56(0xABCDEF01234567)); err != nil {
+			t.Fatal(err)
+		}
+		if err := bw.WriteInt24(MustInt24(-8388608)); err != nil {
+			t.Fatal(err)
+		}
+		if err := bw.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if buf.Len() != 16 {
+			t.Fatalf("order %v: wrote %d bytes, want 16", order, buf.Len())
+		}
+
+		br := NewBitReaderBytes(buf.Bytes(), order)
+		if v, err := br.ReadBits(3); err != nil || v != 0b101 {
+			t.Errorf("order %v: ReadBits(3) = %v, %v", order, v, err)
+		}
+		if v, err := br.ReadInt40(); err != nil || v.Int64() != -123456789 {
+			t.Errorf("order %v: ReadInt40() = %v, %v", order, v, err)
+		}
+		if v, err := br.ReadUint56(); err != nil || v.Uint64() != 0xABCDEF01234567 {
+			t.Errorf("order %v: ReadUint56() = %v, %v", order, v, err)
+		}
+		if v, err := br.ReadInt24(); err != nil || v.Int64() != -8388608 {
+			t.Errorf("order %v: ReadInt24() = %v, %v", order, v, err)
+		}
+	}
+}
+
+func TestBitWriterErrors(t *testing.T) {
+	bw := NewBitWriter(io.Discard, MSBFirst)
+	if err := bw.WriteBits(8, 3); !errors.Is(err, ErrBitValueOverflow) {
+		t.Errorf("WriteBits() error = %v, want %v", err, ErrBitValueOverflow)
+	}
+	if err := bw.WriteBits(0, 65); !errors.Is(err, ErrInvalidBitCount) {
+		t.Errorf("WriteBits() error = %v, want %v", err, ErrInvalidBitCount)
+	}
+}
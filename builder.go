@@ -0,0 +1,217 @@
+package intx
+
+import (
+	"errors"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Common errors for the Builder
+var (
+	ErrBuilderFixedSize     = errors.New("builder exceeds its fixed-size buffer")
+	ErrLengthPrefixOverflow = errors.New("length exceeds range of length prefix")
+)
+
+// A Builder builds byte strings from fixed-width integers and length-prefixed
+// sections, in the style of golang.org/x/crypto/cryptobyte. All integers are
+// written in big-endian order. The first error encountered is kept and
+// returned by Bytes; later calls become no-ops.
+type Builder struct {
+	err       error
+	result    []byte
+	fixedSize bool
+	pending   bool // a child builder is currently writing into result
+}
+
+// BuilderContinuation is a function that writes the body of a
+// length-prefixed section into a child Builder.
+type BuilderContinuation func(child *Builder)
+
+// MarshalingValue is implemented by values that can write themselves into a Builder.
+type MarshalingValue interface {
+	Marshal(b *Builder) error
+}
+
+// NewBuilder creates a Builder that appends to buffer.
+func NewBuilder(buffer []byte) *Builder {
+	return &Builder{result: buffer}
+}
+
+// NewFixedBuilder creates a Builder that appends to buffer and refuses to
+// grow it beyond its capacity.
+func NewFixedBuilder(buffer []byte) *Builder {
+	return &Builder{result: buffer, fixedSize: true}
+}
+
+// SetError records err as the Builder's error if none is set yet.
+func (b *Builder) SetError(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// Bytes returns the built bytes or the first error encountered.
+func (b *Builder) Bytes() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.result, nil
+}
+
+// BytesOrPanic returns the built bytes and panics on error.
+func (b *Builder) BytesOrPanic() []byte {
+	if b.err != nil {
+		panic(b.err)
+	}
+	return b.result
+}
+
+// AddUint8 appends an 8-bit value.
+func (b *Builder) AddUint8(v uint8) { b.add(v) }
+
+// AddUint16 appends a big-endian 16-bit value.
+func (b *Builder) AddUint16(v uint16) { b.add(byte(v>>8), byte(v)) }
+
+// AddUint32 appends a big-endian 32-bit value.
+func (b *Builder) AddUint32(v uint32) {
+	b.add(byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// AddUint64 appends a big-endian 64-bit value.
+func (b *Builder) AddUint64(v uint64) {
+	b.add(byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// AddUint24 appends the 3-byte big-endian form of v.
+func (b *Builder) AddUint24(v int24.Uint24) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddInt24 appends the 3-byte big-endian form of v.
+func (b *Builder) AddInt24(v int24.Int24) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddUint40 appends the 5-byte big-endian form of v.
+func (b *Builder) AddUint40(v int40.Uint40) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddInt40 appends the 5-byte big-endian form of v.
+func (b *Builder) AddInt40(v int40.Int40) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddUint48 appends the 6-byte big-endian form of v.
+func (b *Builder) AddUint48(v int48.Uint48) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddInt48 appends the 6-byte big-endian form of v.
+func (b *Builder) AddInt48(v int48.Int48) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddUint56 appends the 7-byte big-endian form of v.
+func (b *Builder) AddUint56(v int56.Uint56) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddInt56 appends the 7-byte big-endian form of v.
+func (b *Builder) AddInt56(v int56.Int56) {
+	out := v.ToBytes()
+	b.add(out[:]...)
+}
+
+// AddBytes appends v unchanged.
+func (b *Builder) AddBytes(v []byte) { b.add(v...) }
+
+// AddValue calls v.Marshal on the Builder and records any error it returns.
+func (b *Builder) AddValue(v MarshalingValue) {
+	if b.err != nil {
+		return
+	}
+	if err := v.Marshal(b); err != nil {
+		b.err = err
+	}
+}
+
+// AddUint8LengthPrefixed adds a section prefixed by its 1-byte length.
+func (b *Builder) AddUint8LengthPrefixed(f BuilderContinuation) { b.addLengthPrefixed(1, f) }
+
+// AddUint16LengthPrefixed adds a section prefixed by its 2-byte big-endian length.
+func (b *Builder) AddUint16LengthPrefixed(f BuilderContinuation) { b.addLengthPrefixed(2, f) }
+
+// AddUint24LengthPrefixed adds a section prefixed by its 3-byte big-endian length.
+// A section longer than the range of Uint24 sets ErrUint24OutOfRange.
+func (b *Builder) AddUint24LengthPrefixed(f BuilderContinuation) { b.addLengthPrefixed(3, f) }
+
+// AddUint32LengthPrefixed adds a section prefixed by its 4-byte big-endian length.
+func (b *Builder) AddUint32LengthPrefixed(f BuilderContinuation) { b.addLengthPrefixed(4, f) }
+
+func (b *Builder) addLengthPrefixed(lenLen int, f BuilderContinuation) {
+	if b.err != nil {
+		return
+	}
+	offset := len(b.result)
+	b.add(make([]byte, lenLen)...)
+	if b.err != nil {
+		return
+	}
+
+	child := &Builder{result: b.result, fixedSize: b.fixedSize}
+	b.pending = true
+	f(child)
+	b.pending = false
+	b.result = child.result
+	if child.err != nil {
+		b.err = child.err
+		return
+	}
+
+	length := uint64(len(b.result) - offset - lenLen)
+	prefix := b.result[offset : offset+lenLen]
+	switch lenLen {
+	case 3:
+		u, err := int24.NewUint24(length)
+		if err != nil {
+			b.err = err
+			return
+		}
+		out := u.ToBytes()
+		copy(prefix, out[:])
+	default:
+		if length >= uint64(1)<<(8*lenLen) {
+			b.err = ErrLengthPrefixOverflow
+			return
+		}
+		for i := lenLen - 1; i >= 0; i-- {
+			prefix[i] = byte(length)
+			length >>= 8
+		}
+	}
+}
+
+func (b *Builder) add(bytes ...byte) {
+	if b.err != nil {
+		return
+	}
+	if b.pending {
+		panic("intx: attempted write to a Builder while a child builder is active")
+	}
+	if b.fixedSize && len(b.result)+len(bytes) > cap(b.result) {
+		b.err = ErrBuilderFixedSize
+		return
+	}
+	b.result = append(b.result, bytes...)
+}
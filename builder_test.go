@@ -0,0 +1,146 @@
+package intx
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+func TestBuilderIntegers(t *testing.T) {
+	b := NewBuilder(nil)
+	b.AddUint24(MustUint24(0x123456))
+	b.AddInt24(MustInt24(-1))
+	b.AddUint40(MustUint40(0x0102030405))
+	b.AddInt40(MustInt40(-2))
+	b.AddUint48(MustUint48(0x010203040506))
+	b.AddInt48(MustInt48(-3))
+	b.AddUint56(MustUint56(0x01020304050607))
+	b.AddInt56(MustInt56(-4))
+
+	data, err := b.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes() error = %v", err)
+	}
+	if len(data) != 2*(3+5+6+7) {
+		t.Fatalf("Bytes() length = %v, want %v", len(data), 2*(3+5+6+7))
+	}
+
+	s := String(data)
+	var (
+		u24 Uint24
+		i24 Int24
+		u40 Uint40
+		i40 Int40
+		u48 Uint48
+		i48 Int48
+		u56 Uint56
+		i56 Int56
+	)
+	if !s.ReadUint24(&u24) || !s.ReadInt24(&i24) ||
+		!s.ReadUint40(&u40) || !s.ReadInt40(&i40) ||
+		!s.ReadUint48(&u48) || !s.ReadInt48(&i48) ||
+		!s.ReadUint56(&u56) || !s.ReadInt56(&i56) {
+		t.Fatal("Read failed on well-formed input")
+	}
+	if !s.Empty() {
+		t.Errorf("String not empty after reading all values: %x", []byte(s))
+	}
+	if u24.Uint64() != 0x123456 || i24.Int64() != -1 ||
+		u40.Uint64() != 0x0102030405 || i40.Int64() != -2 ||
+		u48.Uint64() != 0x010203040506 || i48.Int64() != -3 ||
+		u56.Uint64() != 0x01020304050607 || i56.Int64() != -4 {
+		t.Errorf("round trip mismatch: %v %v %v %v %v %v %v %v", u24, i24, u40, i40, u48, i48, u56, i56)
+	}
+}
+
+func TestBuilderNestedLengthPrefixed(t *testing.T) {
+	b := NewBuilder(nil)
+	b.AddUint24LengthPrefixed(func(b *Builder) {
+		b.AddUint8(0xAA)
+		b.AddUint16LengthPrefixed(func(b *Builder) {
+			b.AddUint40(MustUint40(0x0102030405))
+		})
+	})
+
+	data, err := b.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes() error = %v", err)
+	}
+	expected := []byte{0x00, 0x00, 0x08, 0xAA, 0x00, 0x05, 0x01, 0x02, 0x03, 0x04, 0x05}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("Bytes() = %x, want %x", data, expected)
+	}
+
+	s := String(data)
+	var outer, inner String
+	var tag uint8
+	var u40 Uint40
+	if !s.ReadUint24LengthPrefixed(&outer) || !outer.ReadUint8(&tag) ||
+		!outer.ReadUint16LengthPrefixed(&inner) || !inner.ReadUint40(&u40) {
+		t.Fatal("Read failed on well-formed input")
+	}
+	if tag != 0xAA || u40.Uint64() != 0x0102030405 || !s.Empty() || !outer.Empty() || !inner.Empty() {
+		t.Errorf("unexpected parse result: tag=%x u40=%v", tag, u40)
+	}
+}
+
+func TestBuilderUint24LengthOverflow(t *testing.T) {
+	b := NewBuilder(nil)
+	b.AddUint24LengthPrefixed(func(b *Builder) {
+		b.AddBytes(make([]byte, 0x1000000))
+	})
+	if _, err := b.Bytes(); !errors.Is(err, ErrUint24OutOfRange) {
+		t.Errorf("Bytes() error = %v, want %v", err, ErrUint24OutOfRange)
+	}
+
+	b = NewBuilder(nil)
+	b.AddUint8LengthPrefixed(func(b *Builder) {
+		b.AddBytes(make([]byte, 256))
+	})
+	if _, err := b.Bytes(); !errors.Is(err, ErrLengthPrefixOverflow) {
+		t.Errorf("Bytes() error = %v, want %v", err, ErrLengthPrefixOverflow)
+	}
+}
+
+func TestBuilderFixedSize(t *testing.T) {
+	b := NewFixedBuilder(make([]byte, 0, 4))
+	b.AddUint24(MustUint24(1))
+	b.AddUint24(MustUint24(2))
+	if _, err := b.Bytes(); !errors.Is(err, ErrBuilderFixedSize) {
+		t.Errorf("Bytes() error = %v, want %v", err, ErrBuilderFixedSize)
+	}
+}
+
+func TestBuilderWriteToParentPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("writing to a parent Builder inside a continuation should panic")
+		}
+	}()
+	b := NewBuilder(nil)
+	b.AddUint24LengthPrefixed(func(*Builder) {
+		b.AddUint8(1)
+	})
+}
+
+func TestStringShortInput(t *testing.T) {
+	s := String{0x00, 0x00, 0x05, 0x01, 0x02}
+	var out String
+	if s.ReadUint24LengthPrefixed(&out) {
+		t.Error("ReadUint24LengthPrefixed() should fail on truncated section")
+	}
+	if len(s) != 5 {
+		t.Errorf("failed read consumed input: %x", []byte(s))
+	}
+
+	var u48 Uint48
+	if s.ReadUint48(&u48) {
+		t.Error("ReadUint48() should fail on short input")
+	}
+}
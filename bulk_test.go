@@ -0,0 +1,336 @@
+package intx
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+// bulkLengths covers the scalar tail, the word-at-a-time path and both together.
+var bulkLengths = []int{0, 1, 2, 3, 17}
+
+func TestBulkUint24(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Uint24, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustUint24(rand.Uint64N(1 << 24))
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		out := make([]byte, n*3)
+		if err := EncodeUint24sBE(out, src); err != nil || !bytes.Equal(out, be) {
+			t.Errorf("EncodeUint24sBE(%d) = %x, %v, want %x", n, out, err, be)
+		}
+		if got := AppendUint24sLE([]byte{0xAA}, src); !bytes.Equal(got[1:], le) || got[0] != 0xAA {
+			t.Errorf("AppendUint24sLE(%d) = %x, want aa%x", n, got, le)
+		}
+
+		back := make([]Uint24, n)
+		if err := DecodeUint24sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint24sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeUint24sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint24sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkInt24(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Int24, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustInt24(rand.Int64N(1<<24) - 1<<23)
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		if got := AppendInt24sBE(nil, src); !bytes.Equal(got, be) {
+			t.Errorf("AppendInt24sBE(%d) = %x, want %x", n, got, be)
+		}
+		out := make([]byte, n*3)
+		if err := EncodeInt24sLE(out, src); err != nil || !bytes.Equal(out, le) {
+			t.Errorf("EncodeInt24sLE(%d) = %x, %v, want %x", n, out, err, le)
+		}
+
+		back := make([]Int24, n)
+		if err := DecodeInt24sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt24sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeInt24sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt24sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkUint40(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Uint40, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustUint40(rand.Uint64N(1 << 40))
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		if got := AppendUint40sBE(nil, src); !bytes.Equal(got, be) {
+			t.Errorf("AppendUint40sBE(%d) = %x, want %x", n, got, be)
+		}
+		if got := AppendUint40sLE(nil, src); !bytes.Equal(got, le) {
+			t.Errorf("AppendUint40sLE(%d) = %x, want %x", n, got, le)
+		}
+
+		back := make([]Uint40, n)
+		if err := DecodeUint40sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint40sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeUint40sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint40sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkInt40(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Int40, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustInt40(rand.Int64N(1<<40) - 1<<39)
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		if got := AppendInt40sBE(nil, src); !bytes.Equal(got, be) {
+			t.Errorf("AppendInt40sBE(%d) = %x, want %x", n, got, be)
+		}
+		if got := AppendInt40sLE(nil, src); !bytes.Equal(got, le) {
+			t.Errorf("AppendInt40sLE(%d) = %x, want %x", n, got, le)
+		}
+
+		back := make([]Int40, n)
+		if err := DecodeInt40sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt40sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeInt40sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt40sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkUint48(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Uint48, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustUint48(rand.Uint64N(1 << 48))
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		if got := AppendUint48sBE(nil, src); !bytes.Equal(got, be) {
+			t.Errorf("AppendUint48sBE(%d) = %x, want %x", n, got, be)
+		}
+		if got := AppendUint48sLE(nil, src); !bytes.Equal(got, le) {
+			t.Errorf("AppendUint48sLE(%d) = %x, want %x", n, got, le)
+		}
+
+		back := make([]Uint48, n)
+		if err := DecodeUint48sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint48sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeUint48sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint48sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkInt48(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Int48, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustInt48(rand.Int64N(1<<48) - 1<<47)
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		if got := AppendInt48sBE(nil, src); !bytes.Equal(got, be) {
+			t.Errorf("AppendInt48sBE(%d) = %x, want %x", n, got, be)
+		}
+		if got := AppendInt48sLE(nil, src); !bytes.Equal(got, le) {
+			t.Errorf("AppendInt48sLE(%d) = %x, want %x", n, got, le)
+		}
+
+		back := make([]Int48, n)
+		if err := DecodeInt48sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt48sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeInt48sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt48sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkUint56(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Uint56, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustUint56(rand.Uint64N(1 << 56))
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		if got := AppendUint56sBE(nil, src); !bytes.Equal(got, be) {
+			t.Errorf("AppendUint56sBE(%d) = %x, want %x", n, got, be)
+		}
+		if got := AppendUint56sLE(nil, src); !bytes.Equal(got, le) {
+			t.Errorf("AppendUint56sLE(%d) = %x, want %x", n, got, le)
+		}
+
+		back := make([]Uint56, n)
+		if err := DecodeUint56sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint56sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeUint56sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeUint56sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkInt56(t *testing.T) {
+	for _, n := range bulkLengths {
+		src := make([]Int56, n)
+		var be, le []byte
+		for i := range src {
+			src[i] = MustInt56(rand.Int64N(1<<56) - 1<<55)
+			b, l := src[i].ToBytes(), src[i].ToLittleEndianBytes()
+			be, le = append(be, b[:]...), append(le, l[:]...)
+		}
+
+		if got := AppendInt56sBE(nil, src); !bytes.Equal(got, be) {
+			t.Errorf("AppendInt56sBE(%d) = %x, want %x", n, got, be)
+		}
+		if got := AppendInt56sLE(nil, src); !bytes.Equal(got, le) {
+			t.Errorf("AppendInt56sLE(%d) = %x, want %x", n, got, le)
+		}
+
+		back := make([]Int56, n)
+		if err := DecodeInt56sBE(back, be); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt56sBE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+		if err := DecodeInt56sLE(back, le); err != nil {
+			t.Fatal(err)
+		}
+		for i := range src {
+			if back[i] != src[i] {
+				t.Errorf("DecodeInt56sLE(%d)[%d] = %v, want %v", n, i, back[i], src[i])
+			}
+		}
+	}
+}
+
+func TestBulkEncodeDoesNotOverrun(t *testing.T) {
+	dst := bytes.Repeat([]byte{0xAA}, 20)
+	if err := EncodeInt40sBE(dst, []Int40{MustInt40(-1), MustInt40(-1)}); err != nil {
+		t.Fatal(err)
+	}
+	for i, b := range dst[10:] {
+		if b != 0xAA {
+			t.Fatalf("EncodeInt40sBE() overwrote byte %d beyond its output", 10+i)
+		}
+	}
+}
+
+func TestBulkLengthErrors(t *testing.T) {
+	if err := EncodeUint48sLE(make([]byte, 11), make([]Uint48, 2)); !errors.Is(err, ErrInt48InvalidByteLength) {
+		t.Errorf("EncodeUint48sLE() error = %v, want %v", err, ErrInt48InvalidByteLength)
+	}
+	if err := DecodeInt24sBE(make([]Int24, 2), make([]byte, 5)); !errors.Is(err, ErrInt24InvalidByteLength) {
+		t.Errorf("DecodeInt24sBE() error = %v, want %v", err, ErrInt24InvalidByteLength)
+	}
+}
@@ -0,0 +1,208 @@
+// Package cbor marshals structs of intx values to and from CBOR (RFC 8949)
+// without external dependencies.
+//
+// It covers the subset of CBOR that small devices typically exchange:
+// integers, booleans, null, byte and text strings, arrays and maps. Every
+// intx type implements Marshaler and Unmarshaler, so intx fields encode as
+// plain CBOR integers in their shortest form and decode with range checks.
+//
+// A struct encodes as a map. Keys default to the field name and can be set
+// with a tag. A tag that is a decimal integer produces an integer key, which
+// keeps messages compact:
+//
+//	type Reading struct {
+//		Sensor int24.Uint24 `cbor:"1"`
+//		Value  int24.Int24  `cbor:"2"`
+//		Note   string       `cbor:"note"`
+//		Debug  string       `cbor:"-"` // skipped
+//	}
+//
+// Decoding ignores map keys that match no field.
+package cbor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// Common errors for the cbor package
+var (
+	ErrUnsupportedType = errors.New("cbor: unsupported type")
+	ErrSyntax          = errors.New("cbor: malformed data item")
+	ErrTrailingData    = errors.New("cbor: trailing data after item")
+	ErrTypeMismatch    = errors.New("cbor: data item does not match Go type")
+	ErrOverflow        = errors.New("cbor: integer overflows Go type")
+	ErrNilPointer      = errors.New("cbor: Unmarshal requires a non-nil pointer")
+)
+
+// Marshaler is implemented by types that encode themselves as a single
+// CBOR data item.
+type Marshaler interface {
+	MarshalCBOR() ([]byte, error)
+}
+
+// Unmarshaler is implemented by types that decode themselves from a single,
+// complete CBOR data item.
+type Unmarshaler interface {
+	UnmarshalCBOR([]byte) error
+}
+
+// CBOR major types.
+const (
+	majorUint   = 0
+	majorNeg    = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorTag    = 6
+	majorSimple = 7
+)
+
+// Simple values.
+const (
+	simpleFalse = 0xF4
+	simpleTrue  = 0xF5
+	simpleNull  = 0xF6
+)
+
+// maxDepth limits nesting when skipping unknown items.
+const maxDepth = 64
+
+var (
+	marshalerType   = reflect.TypeFor[Marshaler]()
+	unmarshalerType = reflect.TypeFor[Unmarshaler]()
+)
+
+// field describes one struct field and its map key.
+type field struct {
+	index  int
+	name   string // text key, if !intKey
+	key    int64  // integer key, if intKey
+	intKey bool
+}
+
+var fieldCache sync.Map // reflect.Type -> []field
+
+func structFields(t reflect.Type) []field {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]field)
+	}
+	var fields []field
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		f := field{index: i, name: sf.Name}
+		if tag, ok := sf.Tag.Lookup("cbor"); ok {
+			if tag == "-" {
+				continue
+			}
+			if k, err := strconv.ParseInt(tag, 10, 64); err == nil {
+				f.key, f.intKey = k, true
+			} else if tag != "" {
+				f.name = tag
+			}
+		}
+		fields = append(fields, f)
+	}
+	actual, _ := fieldCache.LoadOrStore(t, fields)
+	return actual.([]field)
+}
+
+// appendHead appends a CBOR initial byte and argument in shortest form.
+func appendHead(b []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(b, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return append(b, major|25, byte(arg>>8), byte(arg))
+	case arg <= math.MaxUint32:
+		return append(b, major|26, byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+	}
+	return append(b, major|27, byte(arg>>56), byte(arg>>48), byte(arg>>40), byte(arg>>32),
+		byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+}
+
+func appendInt(b []byte, v int64) []byte {
+	if v < 0 {
+		return appendHead(b, majorNeg, uint64(-1-v))
+	}
+	return appendHead(b, majorUint, uint64(v))
+}
+
+// readHead decodes the initial byte and argument at the start of data and
+// returns them with the header length. Indefinite lengths are not
+// supported.
+func readHead(data []byte) (major byte, info byte, arg uint64, n int, err error) {
+	if len(data) == 0 {
+		return 0, 0, 0, 0, ErrSyntax
+	}
+	major, info = data[0]>>5, data[0]&0x1F
+	switch {
+	case info < 24:
+		return major, info, uint64(info), 1, nil
+	case info <= 27:
+		n = 1 << (info - 24)
+	default:
+		return 0, 0, 0, 0, fmt.Errorf("%w: reserved or indefinite-length encoding", ErrSyntax)
+	}
+	if len(data) < 1+n {
+		return 0, 0, 0, 0, ErrSyntax
+	}
+	for _, c := range data[1 : 1+n] {
+		arg = arg<<8 | uint64(c)
+	}
+	return major, info, arg, 1 + n, nil
+}
+
+// itemLen returns the length of the complete data item at the start of data.
+func itemLen(data []byte, depth int) (int, error) {
+	if depth > maxDepth {
+		return 0, fmt.Errorf("%w: nesting too deep", ErrSyntax)
+	}
+	major, _, arg, n, err := readHead(data)
+	if err != nil {
+		return 0, err
+	}
+	switch major {
+	case majorBytes, majorText:
+		if arg > uint64(len(data)-n) {
+			return 0, ErrSyntax
+		}
+		return n + int(arg), nil
+	case majorArray, majorMap:
+		count := arg
+		if major == majorMap {
+			if count > math.MaxUint64/2 {
+				return 0, ErrSyntax
+			}
+			count *= 2
+		}
+		off := n
+		for ; count > 0; count-- {
+			m, err := itemLen(data[off:], depth+1)
+			if err != nil {
+				return 0, err
+			}
+			off += m
+		}
+		return off, nil
+	case majorTag:
+		m, err := itemLen(data[n:], depth+1)
+		if err != nil {
+			return 0, err
+		}
+		return n + m, nil
+	}
+	// Integers, simple values and floats are fully described by the head.
+	return n, nil
+}
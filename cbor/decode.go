@@ -0,0 +1,183 @@
+package cbor
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Unmarshal decodes the single CBOR data item in data into the value
+// pointed to by v. See Marshal for the supported types.
+//
+// Integers of any argument width are accepted and range-checked against
+// the target; intx targets report their own ErrXOutOfRange errors. CBOR
+// null sets pointers, slices and interfaces to nil and leaves other values
+// unchanged.
+func Unmarshal(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return ErrNilPointer
+	}
+	n, err := itemLen(data, 0)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return ErrTrailingData
+	}
+	return decodeValue(rv.Elem(), data)
+}
+
+// decodeValue decodes the complete data item in data into v.
+func decodeValue(v reflect.Value, data []byte) error {
+	if data[0] == simpleNull {
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Interface, reflect.Map:
+			v.SetZero()
+		}
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeValue(v.Elem(), data)
+	}
+	if v.CanAddr() && reflect.PointerTo(v.Type()).Implements(unmarshalerType) {
+		return v.Addr().Interface().(Unmarshaler).UnmarshalCBOR(data)
+	}
+
+	major, _, arg, n, err := readHead(data)
+	if err != nil {
+		return err
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		switch data[0] {
+		case simpleFalse:
+			v.SetBool(false)
+		case simpleTrue:
+			v.SetBool(true)
+		default:
+			return mismatch(data[0], v)
+		}
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if major != majorUint && major != majorNeg {
+			return mismatch(data[0], v)
+		}
+		if arg > math.MaxInt64 {
+			return ErrOverflow
+		}
+		x := int64(arg)
+		if major == majorNeg {
+			x = -1 - x
+		}
+		if v.OverflowInt(x) {
+			return ErrOverflow
+		}
+		v.SetInt(x)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if major == majorNeg {
+			return ErrOverflow
+		}
+		if major != majorUint {
+			return mismatch(data[0], v)
+		}
+		if v.OverflowUint(arg) {
+			return ErrOverflow
+		}
+		v.SetUint(arg)
+		return nil
+	case reflect.String:
+		if major != majorText {
+			return mismatch(data[0], v)
+		}
+		v.SetString(string(data[n:]))
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if major != majorBytes {
+				return mismatch(data[0], v)
+			}
+			v.SetBytes(append([]byte(nil), data[n:]...))
+			return nil
+		}
+		if major != majorArray {
+			return mismatch(data[0], v)
+		}
+		v.Set(reflect.MakeSlice(v.Type(), int(arg), int(arg)))
+		return decodeElems(v, data[n:])
+	case reflect.Array:
+		if major != majorArray || arg != uint64(v.Len()) {
+			return mismatch(data[0], v)
+		}
+		return decodeElems(v, data[n:])
+	case reflect.Struct:
+		if major != majorMap {
+			return mismatch(data[0], v)
+		}
+		return decodeStruct(v, data[n:], arg)
+	}
+	return fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type())
+}
+
+// decodeElems decodes consecutive items in data into the elements of v.
+func decodeElems(v reflect.Value, data []byte) error {
+	for i := range v.Len() {
+		m, _ := itemLen(data, 0) // validated by Unmarshal
+		if err := decodeValue(v.Index(i), data[:m]); err != nil {
+			return err
+		}
+		data = data[m:]
+	}
+	return nil
+}
+
+func decodeStruct(v reflect.Value, data []byte, count uint64) error {
+	fields := structFields(v.Type())
+	for ; count > 0; count-- {
+		kn, _ := itemLen(data, 0)
+		key := data[:kn]
+		data = data[kn:]
+		vn, _ := itemLen(data, 0)
+		val := data[:vn]
+		data = data[vn:]
+
+		f, ok := lookupField(fields, key)
+		if !ok {
+			continue
+		}
+		if err := decodeValue(v.Field(f.index), val); err != nil {
+			return fmt.Errorf("cbor: field %s: %w", v.Type().Field(f.index).Name, err)
+		}
+	}
+	return nil
+}
+
+// lookupField finds the field whose key matches the encoded key item.
+func lookupField(fields []field, key []byte) (field, bool) {
+	major, _, arg, n, _ := readHead(key)
+	for _, f := range fields {
+		switch {
+		case major == majorText && !f.intKey:
+			if string(key[n:]) == f.name {
+				return f, true
+			}
+		case major == majorUint && f.intKey:
+			if f.key >= 0 && uint64(f.key) == arg {
+				return f, true
+			}
+		case major == majorNeg && f.intKey:
+			if f.key < 0 && uint64(-1-f.key) == arg {
+				return f, true
+			}
+		}
+	}
+	return field{}, false
+}
+
+func mismatch(initial byte, v reflect.Value) error {
+	return fmt.Errorf("%w: major type %d into %s", ErrTypeMismatch, initial>>5, v.Type())
+}
@@ -0,0 +1,87 @@
+package cbor
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Marshal returns the CBOR encoding of v.
+//
+// Values implementing Marshaler encode themselves, which covers every intx
+// type. Otherwise bool, signed and unsigned integers, string, []byte,
+// slices, arrays, structs (as maps) and pointers (nil as CBOR null) are
+// supported. Other types return ErrUnsupportedType.
+func Marshal(v any) ([]byte, error) {
+	return appendValue(nil, reflect.ValueOf(v))
+}
+
+func appendValue(b []byte, v reflect.Value) ([]byte, error) {
+	if !v.IsValid() {
+		return append(b, simpleNull), nil
+	}
+	if v.Type().Implements(marshalerType) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return append(b, simpleNull), nil
+		}
+		data, err := v.Interface().(Marshaler).MarshalCBOR()
+		if err != nil {
+			return b, err
+		}
+		return append(b, data...), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, simpleTrue), nil
+		}
+		return append(b, simpleFalse), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return appendInt(b, v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return appendHead(b, majorUint, v.Uint()), nil
+	case reflect.String:
+		b = appendHead(b, majorText, uint64(v.Len()))
+		return append(b, v.String()...), nil
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return append(b, simpleNull), nil
+		}
+		return appendValue(b, v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return append(b, simpleNull), nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b = appendHead(b, majorBytes, uint64(v.Len()))
+			return append(b, v.Bytes()...), nil
+		}
+		fallthrough
+	case reflect.Array:
+		b = appendHead(b, majorArray, uint64(v.Len()))
+		for i := range v.Len() {
+			var err error
+			if b, err = appendValue(b, v.Index(i)); err != nil {
+				return b, err
+			}
+		}
+		return b, nil
+	case reflect.Struct:
+		fields := structFields(v.Type())
+		b = appendHead(b, majorMap, uint64(len(fields)))
+		for _, f := range fields {
+			if f.intKey {
+				b = appendInt(b, f.key)
+			} else {
+				b = appendHead(b, majorText, uint64(len(f.name)))
+				b = append(b, f.name...)
+			}
+			var err error
+			if b, err = appendValue(b, v.Field(f.index)); err != nil {
+				return b, fmt.Errorf("cbor: field %s: %w", v.Type().Field(f.index).Name, err)
+			}
+		}
+		return b, nil
+	}
+	return b, fmt.Errorf("%w: %s", ErrUnsupportedType, v.Type())
+}
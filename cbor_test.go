@@ -0,0 +1,169 @@
+package intx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/cbor"
+
+	"testing"
+)
+
+func TestCBORMarshalShortest(t *testing.T) {
+	tests := []struct {
+		v    cbor.Marshaler
+		want []byte
+	}{
+		{MustInt24(0), []byte{0x00}},
+		{MustInt24(23), []byte{0x17}},
+		{MustInt24(24), []byte{0x18, 0x18}},
+		{MustInt24(-1), []byte{0x20}},
+		{MustInt24(-25), []byte{0x38, 0x18}},
+		{MustInt24(-8388608), []byte{0x3A, 0x00, 0x7F, 0xFF, 0xFF}},
+		{MustUint24(0xFFFF), []byte{0x19, 0xFF, 0xFF}},
+		{MustInt40(1 << 32), []byte{0x1B, 0, 0, 0, 1, 0, 0, 0, 0}},
+		{MustUint40(256), []byte{0x19, 0x01, 0x00}},
+		{MustInt48(-1 << 47), []byte{0x3B, 0, 0, 0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}},
+		{MustUint48(100), []byte{0x18, 0x64}},
+		{MustInt56(1000000), []byte{0x1A, 0x00, 0x0F, 0x42, 0x40}},
+		{MustUint56(0xFFFFFFFFFFFFFF), []byte{0x1B, 0, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}},
+	}
+	for _, tt := range tests {
+		got, err := tt.v.MarshalCBOR()
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("%T(%v).MarshalCBOR() = % x, %v, want % x", tt.v, tt.v, got, err, tt.want)
+		}
+	}
+}
+
+func TestCBORUnmarshalRangeCheck(t *testing.T) {
+	// Non-minimal encodings are accepted.
+	var i Int24
+	if err := i.UnmarshalCBOR([]byte{0x3B, 0, 0, 0, 0, 0, 0, 0, 0x09}); err != nil || i.Int64() != -10 {
+		t.Errorf("Int24.UnmarshalCBOR(8-byte -10) = %v, %v", i, err)
+	}
+	var u Uint56
+	if err := u.UnmarshalCBOR([]byte{0x19, 0x00, 0x05}); err != nil || u.Uint64() != 5 {
+		t.Errorf("Uint56.UnmarshalCBOR(2-byte 5) = %v, %v", u, err)
+	}
+
+	tests := []struct {
+		target cbor.Unmarshaler
+		data   []byte
+		want   error
+	}{
+		{new(Int24), []byte{0x1A, 0x00, 0x80, 0x00, 0x00}, ErrInt24OutOfRange},
+		{new(Int24), []byte{0x3B, 0x80, 0, 0, 0, 0, 0, 0, 0}, ErrInt24OutOfRange},
+		{new(Uint24), []byte{0x20}, ErrUint24OutOfRange},
+		{new(Uint24), []byte{0x1A, 0x01, 0x00, 0x00, 0x00}, ErrUint24OutOfRange},
+		{new(Int40), []byte{0x1B, 0, 0, 0, 0x80, 0, 0, 0, 0}, ErrInt40OutOfRange},
+		{new(Uint40), []byte{0x1B, 0, 0, 0x01, 0, 0, 0, 0, 0}, ErrUint40OutOfRange},
+		{new(Int48), []byte{0x3B, 0, 0, 0x80, 0, 0, 0, 0, 0}, ErrInt48OutOfRange},
+		{new(Uint48), []byte{0x3B, 0, 0, 0, 0, 0, 0, 0, 0}, ErrUint48OutOfRange},
+		{new(Int56), []byte{0x1B, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, ErrInt56OutOfRange},
+		{new(Uint56), []byte{0x1B, 0x01, 0, 0, 0, 0, 0, 0, 0}, ErrUint56OutOfRange},
+		{new(Int24), []byte{0x18}, ErrInt24InvalidCBOR},
+		{new(Int24), []byte{0x00, 0x00}, ErrInt24InvalidCBOR},
+		{new(Int24), []byte{0x1C}, ErrInt24InvalidCBOR},
+		{new(Uint48), []byte{0x60}, ErrInt48InvalidCBOR},
+		{new(Uint40), nil, ErrInt40EmptyData},
+	}
+	for _, tt := range tests {
+		if err := tt.target.UnmarshalCBOR(tt.data); err != tt.want {
+			t.Errorf("%T.UnmarshalCBOR(% x) error = %v, want %v", tt.target, tt.data, err, tt.want)
+		}
+	}
+}
+
+type cborReading struct {
+	Sensor Uint24  `cbor:"1"`
+	Value  Int24   `cbor:"2"`
+	Time   Uint48  `cbor:"3"`
+	Total  *Int56  `cbor:"4"`
+	Note   string  `cbor:"note"`
+	Raw    []byte  `cbor:"raw"`
+	Hist   []Int40 `cbor:"hist"`
+	OK     bool
+	Debug  string `cbor:"-"`
+	secret int
+}
+
+func TestCBORStruct(t *testing.T) {
+	total := MustInt56(-5)
+	r := cborReading{
+		Sensor: MustUint24(7),
+		Value:  MustInt24(-300),
+		Time:   MustUint48(1700000000),
+		Total:  &total,
+		Note:   "ok",
+		Raw:    []byte{0xCA, 0xFE},
+		Hist:   []Int40{MustInt40(1), MustInt40(-1)},
+		OK:     true,
+		Debug:  "dropped",
+	}
+	data, err := cbor.Marshal(r)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := []byte{
+		0xA8,
+		0x01, 0x07,
+		0x02, 0x39, 0x01, 0x2B,
+		0x03, 0x1A, 0x65, 0x53, 0xF1, 0x00,
+		0x04, 0x24,
+		0x64, 'n', 'o', 't', 'e', 0x62, 'o', 'k',
+		0x63, 'r', 'a', 'w', 0x42, 0xCA, 0xFE,
+		0x64, 'h', 'i', 's', 't', 0x82, 0x01, 0x20,
+		0x62, 'O', 'K', 0xF5,
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("Marshal() = % x, want % x", data, want)
+	}
+
+	var got cborReading
+	if err := cbor.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	r.Debug = ""
+	if !reflect.DeepEqual(got, r) {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, r)
+	}
+}
+
+func TestCBORStructErrors(t *testing.T) {
+	var r cborReading
+	// Unknown keys, including nested ones, are skipped.
+	data := []byte{0xA2, 0x09, 0x82, 0xA1, 0x00, 0x00, 0xF9, 0x3C, 0x00, 0x01, 0x05}
+	if err := cbor.Unmarshal(data, &r); err != nil || r.Sensor.Uint64() != 5 {
+		t.Errorf("Unmarshal(unknown keys) = %+v, %v", r, err)
+	}
+
+	// Value 0x800000 does not fit Int24.
+	if err := cbor.Unmarshal([]byte{0xA1, 0x02, 0x1A, 0x00, 0x80, 0x00, 0x00}, &r); !errors.Is(err, ErrInt24OutOfRange) {
+		t.Errorf("Unmarshal(out of range) error = %v, want %v", err, ErrInt24OutOfRange)
+	}
+	if err := cbor.Unmarshal([]byte{0xA1, 0x62, 'O', 'K', 0x01}, &r); !errors.Is(err, cbor.ErrTypeMismatch) {
+		t.Errorf("Unmarshal(mismatch) error = %v, want %v", err, cbor.ErrTypeMismatch)
+	}
+	if err := cbor.Unmarshal([]byte{0xA1, 0x01}, &r); !errors.Is(err, cbor.ErrSyntax) {
+		t.Errorf("Unmarshal(truncated) error = %v, want %v", err, cbor.ErrSyntax)
+	}
+	if err := cbor.Unmarshal([]byte{0xA0, 0x00}, &r); err != cbor.ErrTrailingData {
+		t.Errorf("Unmarshal(trailing) error = %v, want %v", err, cbor.ErrTrailingData)
+	}
+	if err := cbor.Unmarshal([]byte{0xA0}, r); err != cbor.ErrNilPointer {
+		t.Errorf("Unmarshal(non-pointer) error = %v, want %v", err, cbor.ErrNilPointer)
+	}
+	var small struct{ N int8 }
+	if err := cbor.Unmarshal([]byte{0xA1, 0x61, 'N', 0x18, 0x80}, &small); !errors.Is(err, cbor.ErrOverflow) {
+		t.Errorf("Unmarshal(int8 overflow) error = %v, want %v", err, cbor.ErrOverflow)
+	}
+	if _, err := cbor.Marshal(struct{ F float64 }{1}); !errors.Is(err, cbor.ErrUnsupportedType) {
+		t.Errorf("Marshal(float64) error = %v, want %v", err, cbor.ErrUnsupportedType)
+	}
+}
@@ -0,0 +1,212 @@
+package intx
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"testing"
+)
+
+// TestCLI builds cmd/intx and runs it as a support engineer would.
+func TestCLI(t *testing.T) {
+	bin := filepath.Join(t.TempDir(), "intx")
+	if out, err := exec.Command("go", "build", "-o", bin, "./cmd/intx").CombinedOutput(); err != nil {
+		t.Fatalf("go build ./cmd/intx: %v\n%s", err, out)
+	}
+	t.Run("Encode", func(t *testing.T) { testCLIEncode(t, bin) })
+	t.Run("Report", func(t *testing.T) { testCLIReport(t, bin) })
+	t.Run("Errors", func(t *testing.T) { testCLIErrors(t, bin) })
+	t.Run("Batch", func(t *testing.T) { testCLIBatch(t, bin) })
+}
+
+func runCLI(t *testing.T, bin, stdin string, args ...string) (stdout, stderr string, code int) {
+	t.Helper()
+	cmd := exec.Command(bin, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var out, errOut bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &out, &errOut
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		code = exitErr.ExitCode()
+	case err != nil:
+		t.Fatalf("running intx: %v", err)
+	}
+	return out.String(), errOut.String(), code
+}
+
+func testCLIEncode(t *testing.T, bin string) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"encode", "i24", "-123456", "--le"}, "c01dfe\n"},
+		{[]string{"encode", "i24", "-123456", "--be"}, "fe1dc0\n"},
+		{[]string{"encode", "--be", "u40", "0x0102030405", "1"}, "0102030405\n0000000001\n"},
+		{[]string{"encode", "U56", "72057594037927935", "--le"}, "ffffffffffffff\n"},
+		{[]string{"encode", "i48", "-1", "--be"}, "ffffffffffff\n"},
+		{[]string{"encode", "u24", "0123", "--be"}, "00007b\n"},
+		{[]string{"encode", "i24", "-0099", "--be"}, "ffff9d\n"},
+		{[]string{"encode", "u24", "0o17", "0b101", "0XFF", "--be"}, "00000f\n000005\n0000ff\n"},
+		{[]string{"encode", "i56", "-0x80000000000000", "--be"}, "80000000000000\n"},
+	}
+	for _, tt := range tests {
+		got, stderr, code := runCLI(t, bin, "", tt.args...)
+		if got != tt.want || code != 0 {
+			t.Errorf("intx %v = %q (exit %d, stderr %q), want %q", tt.args, got, code, stderr, tt.want)
+		}
+	}
+}
+
+func testCLIReport(t *testing.T, bin string) {
+	want := "type     i24\n" +
+		"decimal  -123456\n" +
+		"hex      -0x1e240\n" +
+		"be       fe 1d c0\n" +
+		"le       c0 1d fe\n" +
+		"as u24   16653760 (0xfe1dc0)\n"
+	for _, args := range [][]string{
+		{"encode", "i24", "-123456"},
+		{"decode", "i24", "fe1dc0"},
+		{"decode", "i24", "--le", "c0:1d:fe"},
+		{"decode", "i24", "0xFE 1D C0"},
+	} {
+		got, stderr, code := runCLI(t, bin, "", args...)
+		if got != want || code != 0 {
+			t.Errorf("intx %v = %q (exit %d, stderr %q), want %q", args, got, code, stderr, want)
+		}
+	}
+
+	got, _, _ := runCLI(t, bin, "", "decode", "u48", "0a0b0c0d0e0f")
+	if !strings.Contains(got, "decimal  11042563100175\n") || !strings.Contains(got, "le       0f 0e 0d 0c 0b 0a\n") {
+		t.Errorf("intx decode u48 0a0b0c0d0e0f = %q", got)
+	}
+}
+
+func testCLIErrors(t *testing.T, bin string) {
+	tests := []struct {
+		args    []string
+		code    int
+		wantErr string
+	}{
+		{[]string{"encode", "i24", "8388608"}, 1, "value exceeds range for Int24"},
+		{[]string{"encode", "u24", "-1"}, 1, "value exceeds maximum for Uint24"},
+		{[]string{"encode", "i56", "99999999999999999999"}, 1, "value exceeds range for Int56"},
+		{[]string{"encode", "i24", "12x"}, 1, `invalid value "12x"`},
+		{[]string{"encode", "i24", "0x"}, 1, `invalid value "0x"`},
+		{[]string{"encode", "u24", "-0x1"}, 1, "value exceeds maximum for Uint24"},
+		{[]string{"encode", "i56", "-9223372036854775809"}, 1, "value exceeds range for Int56"},
+		{[]string{"decode", "u48", "0a0b0c"}, 1, "u48 needs 6 bytes, got 3"},
+		{[]string{"decode", "u24", "0g0000"}, 1, "invalid hex"},
+		{[]string{"encode", "i32", "1"}, 2, `unknown type "i32"`},
+		{[]string{"encode", "i24", "1", "--le", "--be"}, 2, "mutually exclusive"},
+		{[]string{"frobnicate"}, 2, "unknown command"},
+		{nil, 2, "missing command"},
+	}
+	for _, tt := range tests {
+		_, stderr, code := runCLI(t, bin, "", tt.args...)
+		if code != tt.code || !strings.Contains(stderr, tt.wantErr) {
+			t.Errorf("intx %v: exit %d, stderr %q; want exit %d and %q", tt.args, code, stderr, tt.code, tt.wantErr)
+		}
+	}
+}
+
+func testCLIBatch(t *testing.T, bin string) {
+	stdin := "# from capture\n1\n\n16777216\n  0xff  \n"
+	got, stderr, code := runCLI(t, bin, stdin, "encode", "u24", "--be")
+	if got != "000001\n0000ff\n" {
+		t.Errorf("stdout = %q, want %q", got, "000001\n0000ff\n")
+	}
+	if code != 1 || !strings.Contains(stderr, `line 4: "16777216": value exceeds maximum for Uint24`) {
+		t.Errorf("exit %d, stderr %q; want exit 1 and a line 4 range error", code, stderr)
+	}
+
+	got, _, code = runCLI(t, bin, "7f ff ff\n80 00 00\n", "decode", "i24")
+	if code != 0 || strings.Count(got, "type     i24\n") != 2 || !strings.Contains(got, "\n\ntype") ||
+		!strings.Contains(got, "decimal  -8388608\n") {
+		t.Errorf("batch decode = %q (exit %d)", got, code)
+	}
+}
+
+// TestCLIDump builds cmd/intxdump and runs it over a small capture.
+func TestCLIDump(t *testing.T) {
+	dir := t.TempDir()
+	bin := filepath.Join(dir, "intxdump")
+	if out, err := exec.Command("go", "build", "-o", bin, "./cmd/intxdump").CombinedOutput(); err != nil {
+		t.Fatalf("go build ./cmd/intxdump: %v\n%s", err, out)
+	}
+	capture := filepath.Join(dir, "capture.bin")
+	data := []byte{
+		0x00, 0x00, 0x2A, 0x00, 0x00, 0x00, 0x01, 0x00, // 42, 256
+		0xFF, 0xFF, 0xFE, 0x00, 0x00, 0x00, 0x00, 0x05, // -2, 5
+		0x01, 0x02, 0x03, 0x04, // truncated in length
+	}
+	if err := os.WriteFile(capture, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	fields := "-fields=header:i24be,length:u40be"
+
+	got, stderr, code := runCLI(t, bin, "", fields, capture)
+	want := "Record #0 at 0x00000000 (8 bytes)\n" +
+		"  00000000  +0  00 00 2a        header  i24be  42\n" +
+		"  00000003  +3  00 00 00 01 00  length  u40be  256\n" +
+		"Record #1 at 0x00000008 (8 bytes)\n" +
+		"  00000008  +0  ff ff fe        header  i24be  -2\n" +
+		"  0000000b  +3  00 00 00 00 05  length  u40be  5\n" +
+		"Record #2 at 0x00000010 (4 of 8 bytes, truncated)\n" +
+		"  00000010  +0  01 02 03        header  i24be  66051\n" +
+		"  00000013  +3  04              length  u40be  <truncated>\n"
+	if got != want {
+		t.Errorf("hexdump =\n%s\nwant\n%s", got, want)
+	}
+	wantErr := "record #2 at offset 0x10: record Record truncated in field length: have 4 of 8 bytes"
+	if code != 1 || !strings.Contains(stderr, wantErr) {
+		t.Errorf("exit %d, stderr %q; want exit 1 and %q", code, stderr, wantErr)
+	}
+
+	got, stderr, code = runCLI(t, bin, "", "-json", fields, capture)
+	want = `{"record":"Record","index":0,"offset":0,"fields":{"header":42,"length":256}}` + "\n" +
+		`{"record":"Record","index":1,"offset":8,"fields":{"header":-2,"length":5}}` + "\n" +
+		`{"record":"Record","index":2,"offset":16,"truncated":true,"fields":{"header":66051}}` + "\n"
+	if got != want || code != 1 || !strings.Contains(stderr, wantErr) {
+		t.Errorf("-json = %q (exit %d, stderr %q), want %q", got, code, stderr, want)
+	}
+
+	got, _, code = runCLI(t, bin, "", "-json", "-n", "2", fields, capture)
+	want = `{"record":"Record","index":0,"offset":0,"fields":{"header":42,"length":256}}` + "\n" +
+		`{"record":"Record","index":1,"offset":8,"fields":{"header":-2,"length":5}}` + "\n"
+	if got != want || code != 0 {
+		t.Errorf("-json -n 2 = %q (exit %d), want %q", got, code, want)
+	}
+
+	// The same bytes read from stdin through the schema's Packet record.
+	got, _, code = runCLI(t, bin, string(data[:14]), "-schema", "example/packet/packet.schema", "-record", "Packet", "-json")
+	want = `{"record":"Packet","index":0,"offset":0,"fields":{"header":42,"length":256,"checksum":281474943156224}}` + "\n"
+	if got != want || code != 0 {
+		t.Errorf("-schema -record Packet = %q (exit %d), want %q", got, code, want)
+	}
+
+	badSchema := filepath.Join(dir, "bad.schema")
+	if err := os.WriteFile(badSchema, []byte("record R {\n\tx: u24;\n}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, stderr, code := runCLI(t, bin, "", "-schema", badSchema, capture); code != 1 ||
+		!strings.Contains(stderr, badSchema+":2:5: ") {
+		t.Errorf("bad schema: exit %d, stderr %q; want %s:2:5: ...", code, stderr, badSchema)
+	}
+
+	for _, args := range [][]string{
+		{"-fields=id:u24", capture},
+		{"-schema", "example/packet/packet.schema", capture},
+		{"-skip", "100", fields, capture},
+	} {
+		if _, stderr, code := runCLI(t, bin, "", args...); code != 1 || stderr == "" {
+			t.Errorf("intxdump %v: exit %d, stderr %q; want an error", args, code, stderr)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Command intx converts values of the intx types between decimal, hex and
+// their big- and little-endian byte forms. It is meant for checking fields
+// in packet captures and hex dumps by hand.
+//
+// Usage:
+//
+//	intx encode type [--le|--be] [value ...]
+//	intx decode type [--le|--be] [hex ...]
+//	intx types
+//
+// The type is one of i24, u24, i40, u40, i48, u48, i56 or u56. Values are
+// decimal, or hex, octal or binary with a 0x, 0o or 0b prefix; leading
+// zeros do not make a value octal, so 0123 is 123. Byte strings
+// are hex digits, optionally with a 0x prefix and separated by spaces,
+// colons or dashes.
+//
+// encode range-checks each value and prints a report of its decimal and hex
+// forms, both byte orders and its reinterpretation as the type of the same
+// width and opposite signedness. With --le or --be it prints only the bytes
+// in that order, one value per line. decode reads bytes in big-endian order,
+// or little-endian with --le, and prints the same report.
+//
+// Without value arguments, values are read from standard input, one per
+// line; blank lines and lines starting with # are skipped. A value that
+// fails is reported on standard error and the rest are still converted;
+// the exit status is 1 if any value failed and 2 for usage errors.
+//
+// Examples:
+//
+//	$ intx encode i24 -123456 --le
+//	c01dfe
+//	$ intx decode u48 0a0b0c0d0e0f
+//	type     u48
+//	decimal  11042563100175
+//	...
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const usage = `usage:
+	intx encode type [--le|--be] [value ...]
+	intx decode type [--le|--be] [hex ...]
+	intx types
+
+types: i24 u24 i40 u40 i48 u48 i56 u56
+Without value arguments, values are read from standard input, one per line.
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// byteOrder is the byte order selected by --le or --be.
+type byteOrder int
+
+const (
+	orderNone byteOrder = iota
+	orderBig
+	orderLittle
+)
+
+// command is a parsed command line.
+type command struct {
+	name   string
+	typ    *intType
+	order  byteOrder
+	values []string
+}
+
+// run executes the command line args and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	cmd, err := parseArgs(args)
+	if err != nil {
+		fmt.Fprintf(stderr, "intx: %v\n%s", err, usage)
+		return 2
+	}
+	if cmd.name == "help" {
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+	if cmd.name == "types" {
+		printTypes(stdout)
+		return 0
+	}
+
+	w := bufio.NewWriter(stdout)
+	defer w.Flush()
+	status := 0
+	first := true
+	convert := func(where, s string) {
+		out, err := cmd.convert(s)
+		if err != nil {
+			w.Flush()
+			fmt.Fprintf(stderr, "intx: %s%q: %v\n", where, s, err)
+			status = 1
+			return
+		}
+		// Reports are separated by blank lines; bare byte strings are not.
+		if !first && (cmd.name == "decode" || cmd.order == orderNone) {
+			fmt.Fprintln(w)
+		}
+		first = false
+		w.WriteString(out)
+	}
+
+	if len(cmd.values) > 0 {
+		for _, s := range cmd.values {
+			convert("", s)
+		}
+		return status
+	}
+	sc := bufio.NewScanner(stdin)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+		convert(fmt.Sprintf("line %d: ", line), s)
+	}
+	if err := sc.Err(); err != nil {
+		w.Flush()
+		fmt.Fprintf(stderr, "intx: reading standard input: %v\n", err)
+		return 1
+	}
+	return status
+}
+
+// parseArgs parses the command line. Flags may appear anywhere after the
+// command so that negative values such as -123456 are not mistaken for
+// flags.
+func parseArgs(args []string) (*command, error) {
+	if len(args) == 0 {
+		return nil, errors.New("missing command")
+	}
+	cmd := &command{name: args[0]}
+	switch cmd.name {
+	case "help", "-h", "-help", "--help":
+		cmd.name = "help"
+		return cmd, nil
+	case "types":
+		if len(args) > 1 {
+			return nil, errors.New("types takes no arguments")
+		}
+		return cmd, nil
+	case "encode", "decode":
+	default:
+		return nil, fmt.Errorf("unknown command %q", cmd.name)
+	}
+
+	var positional []string
+	for _, arg := range args[1:] {
+		var order byteOrder
+		switch arg {
+		case "--le", "-le":
+			order = orderLittle
+		case "--be", "-be":
+			order = orderBig
+		default:
+			positional = append(positional, arg)
+			continue
+		}
+		if cmd.order != orderNone && cmd.order != order {
+			return nil, errors.New("--le and --be are mutually exclusive")
+		}
+		cmd.order = order
+	}
+	if len(positional) == 0 {
+		return nil, fmt.Errorf("%s: missing type", cmd.name)
+	}
+	if cmd.typ = lookupType(positional[0]); cmd.typ == nil {
+		return nil, fmt.Errorf("unknown type %q", positional[0])
+	}
+	cmd.values = positional[1:]
+	return cmd, nil
+}
+
+// convert converts one value according to the command.
+func (c *command) convert(s string) (string, error) {
+	if c.name == "decode" {
+		b, err := parseHex(s)
+		if err != nil {
+			return "", err
+		}
+		if len(b) != c.typ.size {
+			return "", fmt.Errorf("%s needs %d bytes, got %d", c.typ.name, c.typ.size, len(b))
+		}
+		x, err := c.typ.decode(b, c.order == orderLittle)
+		if err != nil {
+			return "", err
+		}
+		return c.typ.report(x)
+	}
+
+	x, err := c.typ.parseValue(s)
+	if err != nil {
+		return "", err
+	}
+	be, le, err := c.typ.encode(x)
+	if err != nil {
+		return "", err
+	}
+	switch c.order {
+	case orderBig:
+		return hex.EncodeToString(be) + "\n", nil
+	case orderLittle:
+		return hex.EncodeToString(le) + "\n", nil
+	}
+	return c.typ.report(x)
+}
+
+// report describes x, a value of type t, in all its forms. The byte forms
+// and the reinterpretation go through the type's own encoders and decoders.
+func (t *intType) report(x int64) (string, error) {
+	be, le, err := t.encode(x)
+	if err != nil {
+		return "", err
+	}
+	twin := lookupType(t.twin)
+	y, err := twin.decode(be, false)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "type     %s\n", t.name)
+	fmt.Fprintf(&sb, "decimal  %d\n", x)
+	fmt.Fprintf(&sb, "hex      %s\n", formatHex(x))
+	fmt.Fprintf(&sb, "be       % x\n", be)
+	fmt.Fprintf(&sb, "le       % x\n", le)
+	fmt.Fprintf(&sb, "as %s   %d (%s)\n", twin.name, y, formatHex(y))
+	return sb.String(), nil
+}
+
+// printTypes lists the supported types and their ranges.
+func printTypes(w io.Writer) {
+	for _, t := range types {
+		bits := t.size * 8
+		var lo, hi int64
+		if t.signed {
+			lo, hi = -1<<(bits-1), 1<<(bits-1)-1
+		} else {
+			hi = 1<<bits - 1
+		}
+		fmt.Fprintf(w, "%s  %d bytes  %d to %d\n", t.name, t.size, lo, hi)
+	}
+}
+
+// parseHex parses a byte string of hex digits with an optional 0x prefix.
+// Spaces, tabs, colons and dashes between bytes are ignored.
+func parseHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', ':', '-':
+			return -1
+		}
+		return r
+	}, s)
+	if s == "" {
+		return nil, errors.New("no bytes")
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex: %w", err)
+	}
+	return b, nil
+}
+
+// formatHex formats x as 0x-prefixed hex with a leading minus sign for
+// negative values.
+func formatHex(x int64) string {
+	if x < 0 {
+		return "-0x" + strconv.FormatUint(uint64(-x), 16)
+	}
+	return "0x" + strconv.FormatUint(uint64(x), 16)
+}
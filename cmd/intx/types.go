@@ -0,0 +1,210 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// intType converts one intx type between integers and bytes using the
+// type's own constructors and byte functions.
+type intType struct {
+	name   string
+	size   int
+	signed bool
+	twin   string // the type of the same width and opposite signedness
+
+	// encode range-checks x and returns its big- and little-endian bytes.
+	encode func(x int64) (be, le []byte, err error)
+	// decode parses b in the given byte order. Every intx value fits in an
+	// int64.
+	decode func(b []byte, little bool) (int64, error)
+	// outOfRange is the type's range error.
+	outOfRange error
+}
+
+var types = []*intType{
+	{
+		name: "i24", size: 3, signed: true, twin: "u24", outOfRange: int24.ErrInt24OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int24.NewInt24(x)
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int24.FromInt24Bytes
+			if little {
+				from = int24.FromInt24LittleEndianBytes
+			}
+			v, err := from(b)
+			return v.Int64(), err
+		},
+	},
+	{
+		name: "u24", size: 3, twin: "i24", outOfRange: int24.ErrUint24OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int24.NewUint24(uint64(x))
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int24.FromUint24Bytes
+			if little {
+				from = int24.FromUint24LittleEndianBytes
+			}
+			v, err := from(b)
+			return int64(v.Uint64()), err
+		},
+	},
+	{
+		name: "i40", size: 5, signed: true, twin: "u40", outOfRange: int40.ErrInt40OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int40.NewInt40(x)
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int40.FromInt40Bytes
+			if little {
+				from = int40.FromInt40LittleEndianBytes
+			}
+			v, err := from(b)
+			return v.Int64(), err
+		},
+	},
+	{
+		name: "u40", size: 5, twin: "i40", outOfRange: int40.ErrUint40OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int40.NewUint40(uint64(x))
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int40.FromUint40Bytes
+			if little {
+				from = int40.FromUint40LittleEndianBytes
+			}
+			v, err := from(b)
+			return int64(v.Uint64()), err
+		},
+	},
+	{
+		name: "i48", size: 6, signed: true, twin: "u48", outOfRange: int48.ErrInt48OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int48.NewInt48(x)
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int48.FromInt48Bytes
+			if little {
+				from = int48.FromInt48LittleEndianBytes
+			}
+			v, err := from(b)
+			return v.Int64(), err
+		},
+	},
+	{
+		name: "u48", size: 6, twin: "i48", outOfRange: int48.ErrUint48OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int48.NewUint48(uint64(x))
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int48.FromUint48Bytes
+			if little {
+				from = int48.FromUint48LittleEndianBytes
+			}
+			v, err := from(b)
+			return int64(v.Uint64()), err
+		},
+	},
+	{
+		name: "i56", size: 7, signed: true, twin: "u56", outOfRange: int56.ErrInt56OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int56.NewInt56(x)
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int56.FromInt56Bytes
+			if little {
+				from = int56.FromInt56LittleEndianBytes
+			}
+			v, err := from(b)
+			return v.Int64(), err
+		},
+	},
+	{
+		name: "u56", size: 7, twin: "i56", outOfRange: int56.ErrUint56OutOfRange,
+		encode: func(x int64) ([]byte, []byte, error) {
+			v, err := int56.NewUint56(uint64(x))
+			be, le := v.ToBytes(), v.ToLittleEndianBytes()
+			return be[:], le[:], err
+		},
+		decode: func(b []byte, little bool) (int64, error) {
+			from := int56.FromUint56Bytes
+			if little {
+				from = int56.FromUint56LittleEndianBytes
+			}
+			v, err := from(b)
+			return int64(v.Uint64()), err
+		},
+	},
+}
+
+// lookupType returns the type named s, such as "i24" or "U48".
+func lookupType(s string) *intType {
+	for _, t := range types {
+		if strings.EqualFold(t.name, s) {
+			return t
+		}
+	}
+	return nil
+}
+
+// parseValue parses a decimal integer, or a hex, octal or binary one with a
+// 0x, 0o or 0b prefix, optionally negative, into an int64 for t.encode.
+// Without a prefix the value is decimal even with leading zeros, so
+// zero-padded numbers copied from logs keep their meaning. Values no intx
+// type can hold are reported with t's range error.
+func (t *intType) parseValue(s string) (int64, error) {
+	digits, neg := strings.CutPrefix(s, "-")
+	if !neg {
+		digits = strings.TrimPrefix(digits, "+")
+	}
+	base := 10
+	if len(digits) > 2 && digits[0] == '0' {
+		switch digits[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+		if base != 10 {
+			digits = digits[2:]
+		}
+	}
+	u, err := strconv.ParseUint(digits, base, 64)
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return 0, t.outOfRange
+	case err != nil:
+		return 0, fmt.Errorf("invalid value %q", s)
+	case neg && u > 0 && !t.signed, neg && u > 1<<63, !neg && u > math.MaxInt64:
+		return 0, t.outOfRange
+	case neg:
+		return -int64(u), nil
+	}
+	return int64(u), nil
+}
@@ -0,0 +1,234 @@
+// Command intxdump decodes a binary file as a sequence of fixed-size records
+// and prints each field's offset, raw bytes and decoded value, either as an
+// annotated hexdump or as JSON Lines.
+//
+// Usage:
+//
+//	intxdump -schema file [-record name] [flags] [binary-file]
+//	intxdump -fields name:type,... [flags] [binary-file]
+//
+// The record layout comes from a schema file in the language of package
+// github.com/CVDpl/go-intx/schema, or from a comma-separated list of
+// name:type pairs such as "id:u48be,temp:i24le,flags:u8". Without a
+// binary file, or with "-", the data is read from standard input.
+//
+// Fields of the intx widths are decoded with the width packages'
+// FromXBytes and FromXLittleEndianBytes functions. A trailing record cut
+// short by the end of the file is still printed with its complete fields,
+// marked as truncated in both formats, and is reported on standard error
+// with the record number, its offset and the first incomplete field; the
+// exit status is then 1.
+//
+// Example:
+//
+//	$ intxdump -fields header:u24be,length:u40be capture.bin
+//	Record #0 at 0x00000000 (8 bytes)
+//	  00000000  +0  00 00 2a        header  u24be  42
+//	  00000003  +3  00 00 00 01 00  length  u40be  256
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/CVDpl/go-intx/schema"
+)
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.schema, "schema", "", "schema file describing the record layout")
+	flag.StringVar(&opts.record, "record", "", "record to decode (default the schema's only record)")
+	flag.StringVar(&opts.fields, "fields", "", "record layout as name:type,... instead of a schema")
+	flag.BoolVar(&opts.json, "json", false, "emit JSON Lines, one object per record")
+	flag.Int64Var(&opts.skip, "skip", 0, "bytes to skip before the first record")
+	flag.IntVar(&opts.count, "n", 0, "decode at most `n` records (default all)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: intxdump (-schema file [-record name] | -fields name:type,...) [flags] [binary-file]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() > 1 || (opts.schema == "") == (opts.fields == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	opts.input = flag.Arg(0)
+	if err := run(opts); err != nil {
+		fmt.Fprintf(os.Stderr, "intxdump: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	schema, record, fields string
+	json                   bool
+	skip                   int64
+	count                  int
+	input                  string
+}
+
+func run(opts options) error {
+	r, err := loadRecord(opts)
+	if err != nil {
+		return err
+	}
+	data, name, err := readInput(opts.input)
+	if err != nil {
+		return err
+	}
+	if opts.skip < 0 || opts.skip > int64(len(data)) {
+		return fmt.Errorf("%s: cannot skip %d bytes of %d", name, opts.skip, len(data))
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	for i, off := 0, int(opts.skip); off < len(data); i, off = i+1, off+r.Size {
+		if opts.count > 0 && i == opts.count {
+			break
+		}
+		values, err := r.Decode(data[off:])
+		if opts.json {
+			if jerr := writeJSON(w, r, i, off, values); jerr != nil {
+				return jerr
+			}
+		} else {
+			writeDump(w, r, i, off, data[off:min(off+r.Size, len(data))], values)
+		}
+		if err != nil {
+			if ferr := w.Flush(); ferr != nil {
+				return ferr
+			}
+			return fmt.Errorf("%s: record #%d at offset %#x: %w", name, i, off, err)
+		}
+	}
+	return w.Flush()
+}
+
+// loadRecord returns the record layout selected by the flags.
+func loadRecord(opts options) (*schema.Record, error) {
+	if opts.fields != "" {
+		return parseFields(opts.fields)
+	}
+	src, err := os.ReadFile(opts.schema)
+	if err != nil {
+		return nil, err
+	}
+	f, err := schema.Parse(src)
+	var serr *schema.SyntaxError
+	if errors.As(err, &serr) {
+		// file:line:col: message, as compilers report it.
+		return nil, fmt.Errorf("%s:%w", opts.schema, serr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", opts.schema, err)
+	}
+	if opts.record == "" {
+		if len(f.Records) > 1 {
+			return nil, fmt.Errorf("%s declares %d records; choose one with -record", opts.schema, len(f.Records))
+		}
+		return f.Records[0], nil
+	}
+	for _, r := range f.Records {
+		if r.Name == opts.record {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: no record %s", opts.schema, opts.record)
+}
+
+// parseFields builds a record from a list of name:type pairs.
+func parseFields(spec string) (*schema.Record, error) {
+	r := &schema.Record{Name: "Record"}
+	seen := map[string]bool{}
+	for _, pair := range strings.Split(spec, ",") {
+		name, typ, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		name, typ = strings.TrimSpace(name), strings.TrimSpace(typ)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("-fields: %q is not name:type", pair)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("-fields: field %s declared twice", name)
+		}
+		seen[name] = true
+		t, err := schema.ParseType(typ)
+		if err != nil {
+			return nil, fmt.Errorf("-fields: field %s: %w", name, err)
+		}
+		r.Fields = append(r.Fields, &schema.Field{Name: name, Type: t, Offset: r.Size})
+		r.Size += t.Size()
+	}
+	return r, nil
+}
+
+// readInput reads the whole input file, or standard input for "" and "-".
+func readInput(path string) ([]byte, string, error) {
+	if path == "" || path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		return data, "<stdin>", err
+	}
+	data, err := os.ReadFile(path)
+	return data, path, err
+}
+
+// writeDump writes one record as an annotated hexdump. raw holds the
+// record's bytes and values its decoded fields, both of which are short for
+// a truncated record.
+func writeDump(w io.Writer, r *schema.Record, index, off int, raw []byte, values []any) {
+	nameWidth, typeWidth, rawWidth := 0, 0, 0
+	for _, f := range r.Fields {
+		nameWidth = max(nameWidth, len(f.Name))
+		typeWidth = max(typeWidth, len(f.Type.String()))
+		rawWidth = max(rawWidth, 3*f.Type.Size()-1)
+	}
+	offWidth := len(fmt.Sprint(r.Size))
+
+	if len(raw) < r.Size {
+		fmt.Fprintf(w, "%s #%d at %#08x (%d of %d bytes, truncated)\n", r.Name, index, off, len(raw), r.Size)
+	} else {
+		fmt.Fprintf(w, "%s #%d at %#08x (%d bytes)\n", r.Name, index, off, r.Size)
+	}
+	for i, f := range r.Fields {
+		if f.Offset >= len(raw) {
+			break
+		}
+		b := raw[f.Offset:min(f.Offset+f.Type.Size(), len(raw))]
+		value := "<truncated>"
+		if i < len(values) {
+			value = fmt.Sprint(values[i])
+		}
+		fmt.Fprintf(w, "  %08x  +%-*d  %-*s  %-*s  %-*s  %s\n",
+			off+f.Offset, offWidth, f.Offset, rawWidth, fmt.Sprintf("% x", b),
+			nameWidth, f.Name, typeWidth, f.Type, value)
+	}
+}
+
+// writeJSON writes one record as a JSON object on its own line, with the
+// fields in declaration order. intx values encode through their MarshalJSON
+// methods. A truncated record, with fewer values than fields, holds only
+// its complete fields and is marked with "truncated":true.
+func writeJSON(w io.Writer, r *schema.Record, index, off int, values []any) error {
+	name, _ := json.Marshal(r.Name)
+	fmt.Fprintf(w, `{"record":%s,"index":%d,"offset":%d,`, name, index, off)
+	if len(values) < len(r.Fields) {
+		io.WriteString(w, `"truncated":true,`)
+	}
+	io.WriteString(w, `"fields":{`)
+	for i, f := range r.Fields[:len(values)] {
+		key, _ := json.Marshal(f.Name)
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			io.WriteString(w, ",")
+		}
+		fmt.Fprintf(w, "%s:%s", key, value)
+	}
+	_, err := io.WriteString(w, "}}\n")
+	return err
+}
@@ -0,0 +1,60 @@
+// Command intxgen-schema generates Go code for fixed-size binary records
+// described in a small schema language. See package
+// github.com/CVDpl/go-intx/schema for the language.
+//
+// Usage:
+//
+//	intxgen-schema [-package name] [-o output.go] schema-file
+//
+// Without -o the code is written to standard output. A typical use is a
+// go:generate directive next to the schema:
+//
+//	//go:generate go run github.com/CVDpl/go-intx/cmd/intxgen-schema -o packet_gen.go packet.schema
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/CVDpl/go-intx/schema"
+)
+
+func main() {
+	pkg := flag.String("package", "", "Go package name (overrides the schema's package clause)")
+	out := flag.String("o", "", "output file (default standard output)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: intxgen-schema [-package name] [-o output.go] schema-file\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), *pkg, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "intxgen-schema: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(path, pkg, out string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	f, err := schema.Parse(src)
+	if err != nil {
+		return fmt.Errorf("%s:%w", path, err)
+	}
+	code, err := schema.Generate(f, schema.Options{Package: pkg, Source: filepath.Base(path)})
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(code)
+		return err
+	}
+	return os.WriteFile(out, code, 0o644)
+}
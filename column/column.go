@@ -0,0 +1,230 @@
+// Package column implements a small self-describing file format for columns
+// of intx values.
+//
+// A column file is a 24-byte header followed by Count values packed back to
+// back at their wire width:
+//
+//	offset size field
+//	0      4    magic "IXCL"
+//	4      1    format version (1)
+//	5      1    Type
+//	6      1    width in bits (24, 40, 48 or 56)
+//	7      1    flags: bit 0 signed, bit 1 little-endian
+//	8      8    value count, big-endian
+//	16     4    CRC-32 (IEEE) of the value bytes, big-endian
+//	20     4    reserved, zero
+package column
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Common errors for the column package
+var (
+	ErrBadMagic           = errors.New("not a column file")
+	ErrUnsupportedVersion = errors.New("unsupported column file version")
+	ErrInvalidHeader      = errors.New("invalid column file header")
+	ErrTypeMismatch       = errors.New("column type does not match requested type")
+	ErrChecksum           = errors.New("column checksum mismatch")
+	ErrTruncated          = errors.New("column file is truncated")
+	ErrClosed             = errors.New("column writer is closed")
+)
+
+// HeaderSize is the size of the column file header in bytes.
+const HeaderSize = 24
+
+const (
+	version = 1
+
+	flagSigned       = 1 << 0
+	flagLittleEndian = 1 << 1
+)
+
+var magic = [4]byte{'I', 'X', 'C', 'L'}
+
+// Type identifies the intx type stored in a column.
+type Type uint8
+
+// Column types.
+const (
+	TypeInt24 Type = iota + 1
+	TypeUint24
+	TypeInt40
+	TypeUint40
+	TypeInt48
+	TypeUint48
+	TypeInt56
+	TypeUint56
+)
+
+var typeNames = [...]string{"", "Int24", "Uint24", "Int40", "Uint40", "Int48", "Uint48", "Int56", "Uint56"}
+
+// Valid reports whether t is a known column type.
+func (t Type) Valid() bool { return t >= TypeInt24 && t <= TypeUint56 }
+
+// Bits returns the width of the type in bits.
+func (t Type) Bits() int { return 8 * t.Size() }
+
+// Size returns the width of the type in bytes.
+func (t Type) Size() int {
+	switch t {
+	case TypeInt24, TypeUint24:
+		return 3
+	case TypeInt40, TypeUint40:
+		return 5
+	case TypeInt48, TypeUint48:
+		return 6
+	case TypeInt56, TypeUint56:
+		return 7
+	}
+	return 0
+}
+
+// Signed reports whether the type is signed.
+func (t Type) Signed() bool { return t.Valid() && (t-TypeInt24)%2 == 0 }
+
+// String returns the name of the intx type, such as "Uint48".
+func (t Type) String() string {
+	if !t.Valid() {
+		return "Type(" + strconv.Itoa(int(t)) + ")"
+	}
+	return typeNames[t]
+}
+
+// Header describes a column file.
+type Header struct {
+	Type     Type
+	Order    binary.ByteOrder
+	Count    uint64
+	Checksum uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Header.
+func (h Header) MarshalBinary() ([]byte, error) {
+	if !h.Type.Valid() {
+		return nil, ErrInvalidHeader
+	}
+	b := make([]byte, HeaderSize)
+	copy(b, magic[:])
+	b[4] = version
+	b[5] = byte(h.Type)
+	b[6] = byte(h.Type.Bits())
+	if h.Type.Signed() {
+		b[7] |= flagSigned
+	}
+	if isLittleEndian(h.Order) {
+		b[7] |= flagLittleEndian
+	}
+	binary.BigEndian.PutUint64(b[8:], h.Count)
+	binary.BigEndian.PutUint32(b[16:], h.Checksum)
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Header. It
+// rejects headers whose width or signedness disagree with their type.
+func (h *Header) UnmarshalBinary(b []byte) error {
+	if len(b) != HeaderSize {
+		return ErrInvalidHeader
+	}
+	if [4]byte(b[:4]) != magic {
+		return ErrBadMagic
+	}
+	if b[4] != version {
+		return ErrUnsupportedVersion
+	}
+	t := Type(b[5])
+	if !t.Valid() || int(b[6]) != t.Bits() || (b[7]&flagSigned != 0) != t.Signed() ||
+		b[7]&^(flagSigned|flagLittleEndian) != 0 {
+		return ErrInvalidHeader
+	}
+	h.Type = t
+	h.Order = binary.ByteOrder(binary.BigEndian)
+	if b[7]&flagLittleEndian != 0 {
+		h.Order = binary.LittleEndian
+	}
+	h.Count = binary.BigEndian.Uint64(b[8:])
+	h.Checksum = binary.BigEndian.Uint32(b[16:])
+	return nil
+}
+
+// ReadHeader reads and validates a column header from r.
+func ReadHeader(r io.Reader) (Header, error) {
+	var b [HeaderSize]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return Header{}, ErrTruncated
+		}
+		return Header{}, err
+	}
+	var h Header
+	err := h.UnmarshalBinary(b[:])
+	return h, err
+}
+
+func isLittleEndian(order binary.ByteOrder) bool {
+	return order != nil && order.Uint16([]byte{1, 0}) == 1
+}
+
+// Value is the set of types that can be stored in a column.
+type Value interface {
+	int24.Int24 | int24.Uint24 | int40.Int40 | int40.Uint40 |
+		int48.Int48 | int48.Uint48 | int56.Int56 | int56.Uint56
+}
+
+// TypeOf returns the column Type for T.
+func TypeOf[T Value]() Type { return codecFor[T]().typ }
+
+// codec groups the width package's bulk functions for one type.
+type codec[T Value] struct {
+	typ      Type
+	appendBE func([]byte, []T) []byte
+	appendLE func([]byte, []T) []byte
+	decodeBE func([]T, []byte) error
+	decodeLE func([]T, []byte) error
+}
+
+func (c codec[T]) append(dst []byte, src []T, little bool) []byte {
+	if little {
+		return c.appendLE(dst, src)
+	}
+	return c.appendBE(dst, src)
+}
+
+func (c codec[T]) decode(dst []T, src []byte, little bool) error {
+	if little {
+		return c.decodeLE(dst, src)
+	}
+	return c.decodeBE(dst, src)
+}
+
+func codecFor[T Value]() codec[T] {
+	var zero T
+	var c any
+	switch any(zero).(type) {
+	case int24.Int24:
+		c = codec[int24.Int24]{TypeInt24, int24.AppendInt24sBE, int24.AppendInt24sLE, int24.DecodeInt24sBE, int24.DecodeInt24sLE}
+	case int24.Uint24:
+		c = codec[int24.Uint24]{TypeUint24, int24.AppendUint24sBE, int24.AppendUint24sLE, int24.DecodeUint24sBE, int24.DecodeUint24sLE}
+	case int40.Int40:
+		c = codec[int40.Int40]{TypeInt40, int40.AppendInt40sBE, int40.AppendInt40sLE, int40.DecodeInt40sBE, int40.DecodeInt40sLE}
+	case int40.Uint40:
+		c = codec[int40.Uint40]{TypeUint40, int40.AppendUint40sBE, int40.AppendUint40sLE, int40.DecodeUint40sBE, int40.DecodeUint40sLE}
+	case int48.Int48:
+		c = codec[int48.Int48]{TypeInt48, int48.AppendInt48sBE, int48.AppendInt48sLE, int48.DecodeInt48sBE, int48.DecodeInt48sLE}
+	case int48.Uint48:
+		c = codec[int48.Uint48]{TypeUint48, int48.AppendUint48sBE, int48.AppendUint48sLE, int48.DecodeUint48sBE, int48.DecodeUint48sLE}
+	case int56.Int56:
+		c = codec[int56.Int56]{TypeInt56, int56.AppendInt56sBE, int56.AppendInt56sLE, int56.DecodeInt56sBE, int56.DecodeInt56sLE}
+	case int56.Uint56:
+		c = codec[int56.Uint56]{TypeUint56, int56.AppendUint56sBE, int56.AppendUint56sLE, int56.DecodeUint56sBE, int56.DecodeUint56sLE}
+	}
+	return c.(codec[T])
+}
@@ -0,0 +1,110 @@
+package column
+
+import (
+	"bufio"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// readChunk is the number of values Reader.ReadAll decodes at a time.
+const readChunk = 4096
+
+// Reader reads a column of values of type T.
+type Reader[T Value] struct {
+	r         io.Reader
+	header    Header
+	codec     codec[T]
+	little    bool
+	remaining uint64
+	crc       hash.Hash32
+	buf       []byte
+}
+
+// NewReader reads the column header from r and returns a Reader for its
+// values. It returns ErrTypeMismatch if the column does not hold T.
+func NewReader[T Value](r io.Reader) (*Reader[T], error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	c := codecFor[T]()
+	if h.Type != c.typ {
+		return nil, ErrTypeMismatch
+	}
+	return &Reader[T]{
+		r:         r,
+		header:    h,
+		codec:     c,
+		little:    isLittleEndian(h.Order),
+		remaining: h.Count,
+		crc:       crc32.NewIEEE(),
+	}, nil
+}
+
+// Header returns the column header.
+func (r *Reader[T]) Header() Header { return r.header }
+
+// Read decodes up to len(dst) values into dst and returns the number read.
+// At the end of the column it verifies the checksum and returns io.EOF, or
+// ErrChecksum if the values do not match the header.
+func (r *Reader[T]) Read(dst []T) (int, error) {
+	if r.remaining == 0 {
+		if r.crc.Sum32() != r.header.Checksum {
+			return 0, ErrChecksum
+		}
+		return 0, io.EOF
+	}
+	n := len(dst)
+	if uint64(n) > r.remaining {
+		n = int(r.remaining)
+	}
+	size := r.header.Type.Size()
+	if cap(r.buf) < n*size {
+		r.buf = make([]byte, n*size)
+	}
+	b := r.buf[:n*size]
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return 0, ErrTruncated
+		}
+		return 0, err
+	}
+	r.crc.Write(b)
+	if err := r.codec.decode(dst[:n], b, r.little); err != nil {
+		return 0, err
+	}
+	r.remaining -= uint64(n)
+	return n, nil
+}
+
+// ReadAll reads the remaining values of the column and verifies the checksum.
+func (r *Reader[T]) ReadAll() ([]T, error) {
+	var out []T
+	chunk := make([]T, readChunk)
+	for {
+		n, err := r.Read(chunk)
+		out = append(out, chunk[:n]...)
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ReadFile reads the column file named path as values of type T.
+func ReadFile[T Value](path string) ([]T, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r, err := NewReader[T](bufio.NewReader(f))
+	if err != nil {
+		return nil, err
+	}
+	return r.ReadAll()
+}
@@ -0,0 +1,105 @@
+package column
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// Writer writes a column of values of type T. The header is written with
+// a zero count and checksum when the Writer is created and rewritten by
+// Close, so the destination must be seekable.
+type Writer[T Value] struct {
+	w      io.WriteSeeker
+	start  int64
+	header Header
+	codec  codec[T]
+	little bool
+	crc    hash.Hash32
+	buf    []byte
+	closed bool
+}
+
+// NewWriter writes a column header for T at the current position of w and
+// returns a Writer for the values. A nil order means big-endian.
+func NewWriter[T Value](w io.WriteSeeker, order binary.ByteOrder) (*Writer[T], error) {
+	start, err := w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	c := codecFor[T]()
+	little := isLittleEndian(order)
+	h := Header{Type: c.typ, Order: binary.ByteOrder(binary.BigEndian)}
+	if little {
+		h.Order = binary.LittleEndian
+	}
+	b, err := h.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	return &Writer[T]{w: w, start: start, header: h, codec: c, little: little, crc: crc32.NewIEEE()}, nil
+}
+
+// Write appends values to the column.
+func (w *Writer[T]) Write(values ...T) error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.buf = w.codec.append(w.buf[:0], values, w.little)
+	if _, err := w.w.Write(w.buf); err != nil {
+		return err
+	}
+	w.crc.Write(w.buf)
+	w.header.Count += uint64(len(values))
+	return nil
+}
+
+// Close rewrites the header with the final count and checksum and leaves
+// w positioned after the last value. It does not close the underlying writer.
+func (w *Writer[T]) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+	end, err := w.w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	w.header.Checksum = w.crc.Sum32()
+	b, err := w.header.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if _, err := w.w.Seek(w.start, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	_, err = w.w.Seek(end, io.SeekStart)
+	return err
+}
+
+// WriteFile writes values to a new column file named path.
+func WriteFile[T Value](path string, values []T, order binary.ByteOrder) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w, err := NewWriter[T](f, order)
+	if err == nil {
+		err = w.Write(values...)
+	}
+	if err == nil {
+		err = w.Close()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
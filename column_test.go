@@ -0,0 +1,145 @@
+package intx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/48"
+	"github.com/CVDpl/go-intx/column"
+
+	"testing"
+)
+
+func TestColumnFileRoundTrip(t *testing.T) {
+	values := []Uint48{MustUint48(1), MustUint48(0x123456789ABC), MustUint48(0xFFFFFFFFFFFF)}
+	path := filepath.Join(t.TempDir(), "ids.col")
+	if err := column.WriteFile(path, values, binary.LittleEndian); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != column.HeaderSize+3*6 {
+		t.Fatalf("file size = %d, want %d", len(data), column.HeaderSize+3*6)
+	}
+	h, err := column.ReadHeader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ReadHeader() error = %v", err)
+	}
+	if h.Type != column.TypeUint48 || h.Type.Signed() || h.Type.Bits() != 48 ||
+		h.Order != binary.LittleEndian || h.Count != 3 {
+		t.Errorf("ReadHeader() = %+v", h)
+	}
+
+	got, err := column.ReadFile[Uint48](path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if len(got) != len(values) {
+		t.Fatalf("ReadFile() returned %d values, want %d", len(got), len(values))
+	}
+	for i := range values {
+		if got[i] != values[i] {
+			t.Errorf("ReadFile()[%d] = %v, want %v", i, got[i], values[i])
+		}
+	}
+
+	if _, err := column.ReadFile[Int48](path); err != column.ErrTypeMismatch {
+		t.Errorf("ReadFile[Int48]() error = %v, want %v", err, column.ErrTypeMismatch)
+	}
+}
+
+// seekBuffer is an in-memory io.WriteSeeker.
+type seekBuffer struct {
+	data []byte
+	pos  int
+}
+
+func (b *seekBuffer) Write(p []byte) (int, error) {
+	if need := b.pos + len(p); need > len(b.data) {
+		b.data = append(b.data, make([]byte, need-len(b.data))...)
+	}
+	copy(b.data[b.pos:], p)
+	b.pos += len(p)
+	return len(p), nil
+}
+
+func (b *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += int64(b.pos)
+	case io.SeekEnd:
+		offset += int64(len(b.data))
+	}
+	b.pos = int(offset)
+	return offset, nil
+}
+
+func TestColumnStreaming(t *testing.T) {
+	var buf seekBuffer
+	w, err := column.NewWriter[Int24](&buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := -5; i < 5; i++ {
+		if err := w.Write(MustInt24(int64(i) * 1000)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(MustInt24(0)); err != column.ErrClosed {
+		t.Errorf("Write() after Close error = %v, want %v", err, column.ErrClosed)
+	}
+
+	r, err := column.NewReader[Int24](bytes.NewReader(buf.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Header().Count != 10 || r.Header().Order != binary.BigEndian {
+		t.Errorf("Header() = %+v", r.Header())
+	}
+	chunk := make([]Int24, 4)
+	var got []Int24
+	for {
+		n, err := r.Read(chunk)
+		got = append(got, chunk[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != 10 || got[0].Int64() != -5000 || got[9].Int64() != 4000 {
+		t.Errorf("Read() = %v", got)
+	}
+
+	corrupt := bytes.Clone(buf.data)
+	corrupt[column.HeaderSize] ^= 0x01
+	r, _ = column.NewReader[Int24](bytes.NewReader(corrupt))
+	if _, err := r.ReadAll(); err != column.ErrChecksum {
+		t.Errorf("ReadAll() error = %v, want %v", err, column.ErrChecksum)
+	}
+
+	r, _ = column.NewReader[Int24](bytes.NewReader(buf.data[:len(buf.data)-1]))
+	if _, err := r.ReadAll(); err != column.ErrTruncated {
+		t.Errorf("ReadAll() error = %v, want %v", err, column.ErrTruncated)
+	}
+
+	bad := bytes.Clone(buf.data)
+	bad[6] = 40
+	if _, err := column.NewReader[Int24](bytes.NewReader(bad)); err != column.ErrInvalidHeader {
+		t.Errorf("NewReader() error = %v, want %v", err, column.ErrInvalidHeader)
+	}
+	if _, err := column.NewReader[Int24](bytes.NewReader([]byte("not a column file at all"))); err != column.ErrBadMagic {
+		t.Errorf("NewReader() error = %v, want %v", err, column.ErrBadMagic)
+	}
+}
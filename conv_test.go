@@ -0,0 +1,48 @@
+package intx
+
+import (
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/internal/conv"
+
+	"testing"
+)
+
+// TestConvUnchecked checks that conv.Unchecked, which writes into the
+// types' storage directly, agrees with the range-checked constructors.
+func TestConvUnchecked(t *testing.T) {
+	testUnchecked[Int24](t)
+	testUnchecked[Uint24](t)
+	testUnchecked[Int40](t)
+	testUnchecked[Uint40](t)
+	testUnchecked[Int48](t)
+	testUnchecked[Uint48](t)
+	testUnchecked[Int56](t)
+	testUnchecked[Uint56](t)
+}
+
+func testUnchecked[T conv.Value](t *testing.T) {
+	t.Helper()
+	c := conv.For[T]()
+	lo, hi := conv.Bounds[T]()
+	if _, err := c.FromInt(lo - 1); err == nil {
+		t.Errorf("%T: Bounds() lo = %d, but %d is accepted", *new(T), lo, lo-1)
+	}
+	if _, err := c.FromInt(hi + 1); err == nil {
+		t.Errorf("%T: Bounds() hi = %d, but %d is accepted", *new(T), hi, hi+1)
+	}
+	for _, x := range []int64{lo, lo + 1, lo / 2, 0, 1, hi / 2, hi - 1, hi} {
+		if x < lo {
+			continue
+		}
+		want, err := c.FromInt(x)
+		if err != nil {
+			t.Fatalf("%T: FromInt(%d) error = %v", want, x, err)
+		}
+		if got := conv.Unchecked[T](x); got != want || c.ToInt(got) != x {
+			t.Errorf("Unchecked[%T](%d) = %v, want %v", want, x, got, want)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Package csvbind reads and writes CSV records as structs, matching columns
+// to fields by header name.
+//
+// A field binds to the column named by its `csv` tag, or by its name when
+// it has no tag; header names are matched exactly first, then without
+// regard to case. Fields tagged `csv:"-"`, unexported fields and columns
+// with no matching field are ignored.
+//
+// Field values are converted as follows:
+//
+//   - intx types parse as decimal with the width package's range checks, so
+//     a Uint48 column rejects 2^48 with ErrUint48OutOfRange;
+//   - types implementing encoding.TextUnmarshaler and encoding.TextMarshaler
+//     use those methods, which also covers NullInt24 and friends (an empty
+//     cell is NULL), time.Time and netip.Addr;
+//   - string, bool, and Go integer and floating-point kinds use strconv.
+//
+// Conversion errors are returned as *ParseError with the line, column and
+// header of the offending cell.
+package csvbind
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Common errors for the csvbind package
+var (
+	ErrNotStruct       = errors.New("csvbind: type parameter must be a struct")
+	ErrUnsupportedType = errors.New("csvbind: unsupported field type")
+	ErrDuplicateColumn = errors.New("csvbind: two columns bind to the same field")
+)
+
+// ParseError reports a cell that could not be converted to its field.
+type ParseError struct {
+	Line   int    // line of the cell in the input, starting at 1
+	Column int    // column of the cell, starting at 1
+	Header string // header name of the column
+	Field  string // name of the struct field
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("csvbind: line %d, column %d (%q): %v", e.Line, e.Column, e.Header, e.Err)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// scanner is implemented by the intx types; Scan accepts a decimal string
+// and range-checks it.
+type scanner interface {
+	Scan(src any) error
+}
+
+var (
+	intxTypes = map[reflect.Type]bool{
+		reflect.TypeFor[int24.Int24](): true, reflect.TypeFor[int24.Uint24](): true,
+		reflect.TypeFor[int40.Int40](): true, reflect.TypeFor[int40.Uint40](): true,
+		reflect.TypeFor[int48.Int48](): true, reflect.TypeFor[int48.Uint48](): true,
+		reflect.TypeFor[int56.Int56](): true, reflect.TypeFor[int56.Uint56](): true,
+	}
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// field is a bindable struct field.
+type field struct {
+	index  int
+	name   string // column name
+	decode func(reflect.Value, string) error
+	encode func(reflect.Value) (string, error)
+}
+
+// fieldsOf returns the bindable fields of struct type t in declaration
+// order.
+func fieldsOf(t reflect.Type) ([]field, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+	var fields []field
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		dec, enc := codecFor(sf.Type)
+		if dec == nil {
+			return nil, fmt.Errorf("%w: field %s has type %s", ErrUnsupportedType, sf.Name, sf.Type)
+		}
+		fields = append(fields, field{index: i, name: name, decode: dec, encode: enc})
+	}
+	return fields, nil
+}
+
+// codecFor returns the cell conversion functions for type t, or nils if t is
+// not supported.
+func codecFor(t reflect.Type) (func(reflect.Value, string) error, func(reflect.Value) (string, error)) {
+	switch {
+	case intxTypes[t]:
+		return decodeIntx, encodeIntx
+	case reflect.PointerTo(t).Implements(textUnmarshalerType) && t.Implements(textMarshalerType):
+		return decodeText, encodeText
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return decodeString, encodeString
+	case reflect.Bool:
+		return decodeBool, encodeBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return decodeInt, encodeInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return decodeUint, encodeUint
+	case reflect.Float32, reflect.Float64:
+		return decodeFloat, encodeFloat
+	}
+	return nil, nil
+}
+
+func decodeIntx(v reflect.Value, s string) error {
+	return v.Addr().Interface().(scanner).Scan(s)
+}
+
+func encodeIntx(v reflect.Value) (string, error) {
+	return v.Interface().(fmt.Stringer).String(), nil
+}
+
+func decodeText(v reflect.Value, s string) error {
+	return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+}
+
+func encodeText(v reflect.Value) (string, error) {
+	b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+	return string(b), err
+}
+
+func decodeString(v reflect.Value, s string) error {
+	v.SetString(s)
+	return nil
+}
+
+func encodeString(v reflect.Value) (string, error) { return v.String(), nil }
+
+func decodeBool(v reflect.Value, s string) error {
+	b, err := strconv.ParseBool(s)
+	v.SetBool(b)
+	return err
+}
+
+func encodeBool(v reflect.Value) (string, error) { return strconv.FormatBool(v.Bool()), nil }
+
+func decodeInt(v reflect.Value, s string) error {
+	x, err := strconv.ParseInt(s, 10, v.Type().Bits())
+	v.SetInt(x)
+	return err
+}
+
+func encodeInt(v reflect.Value) (string, error) { return strconv.FormatInt(v.Int(), 10), nil }
+
+func decodeUint(v reflect.Value, s string) error {
+	x, err := strconv.ParseUint(s, 10, v.Type().Bits())
+	v.SetUint(x)
+	return err
+}
+
+func encodeUint(v reflect.Value) (string, error) { return strconv.FormatUint(v.Uint(), 10), nil }
+
+func decodeFloat(v reflect.Value, s string) error {
+	x, err := strconv.ParseFloat(s, v.Type().Bits())
+	v.SetFloat(x)
+	return err
+}
+
+func encodeFloat(v reflect.Value) (string, error) {
+	return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+}
+
+// lookup returns the field bound to header name, or -1.
+func lookup(fields []field, name string) int {
+	for i, f := range fields {
+		if f.name == name {
+			return i
+		}
+	}
+	for i, f := range fields {
+		if strings.EqualFold(f.name, name) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,90 @@
+package csvbind
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Reader decodes CSV records into values of struct type T.
+type Reader[T any] struct {
+	r       *csv.Reader
+	header  []string
+	fields  []field
+	columns []int // field index for each column, or -1
+}
+
+// NewReader reads the header record from r and binds its columns to the
+// fields of T. Configure r (separator, comments, and so on) before calling
+// NewReader.
+func NewReader[T any](r *csv.Reader) (*Reader[T], error) {
+	fields, err := fieldsOf(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	header = append([]string(nil), header...) // r may reuse its record
+	columns := make([]int, len(header))
+	bound := make([]bool, len(fields))
+	for i, name := range header {
+		columns[i] = lookup(fields, name)
+		if f := columns[i]; f >= 0 {
+			if bound[f] {
+				return nil, fmt.Errorf("%w: %q", ErrDuplicateColumn, name)
+			}
+			bound[f] = true
+		}
+	}
+	return &Reader[T]{r: r, header: header, fields: fields, columns: columns}, nil
+}
+
+// Header returns the header record.
+func (r *Reader[T]) Header() []string { return r.header }
+
+// Read decodes the next record. It returns io.EOF when there are no more
+// records. Fields without a column keep their zero value.
+func (r *Reader[T]) Read() (T, error) {
+	var v T
+	record, err := r.r.Read()
+	if err != nil {
+		return v, err
+	}
+	rv := reflect.ValueOf(&v).Elem()
+	for i, cell := range record {
+		if i >= len(r.columns) || r.columns[i] < 0 {
+			continue
+		}
+		f := r.fields[r.columns[i]]
+		if err := f.decode(rv.Field(f.index), cell); err != nil {
+			line, _ := r.r.FieldPos(i)
+			return v, &ParseError{
+				Line:   line,
+				Column: i + 1,
+				Header: r.header[i],
+				Field:  reflect.TypeFor[T]().Field(f.index).Name,
+				Err:    err,
+			}
+		}
+	}
+	return v, nil
+}
+
+// ReadAll decodes all remaining records.
+func (r *Reader[T]) ReadAll() ([]T, error) {
+	var out []T
+	for {
+		v, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return out, err
+		}
+		out = append(out, v)
+	}
+}
@@ -0,0 +1,51 @@
+package csvbind
+
+import (
+	"encoding/csv"
+	"fmt"
+	"reflect"
+)
+
+// Writer encodes values of struct type T as CSV records, one column per
+// bindable field in declaration order.
+type Writer[T any] struct {
+	w      *csv.Writer
+	fields []field
+	record []string
+}
+
+// NewWriter writes the header record for T to w.
+func NewWriter[T any](w *csv.Writer) (*Writer[T], error) {
+	fields, err := fieldsOf(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &Writer[T]{w: w, fields: fields, record: make([]string, len(fields))}, nil
+}
+
+// Write encodes v as one record.
+func (w *Writer[T]) Write(v T) error {
+	rv := reflect.ValueOf(v)
+	for i, f := range w.fields {
+		s, err := f.encode(rv.Field(f.index))
+		if err != nil {
+			return fmt.Errorf("csvbind: field %s: %w", reflect.TypeFor[T]().Field(f.index).Name, err)
+		}
+		w.record[i] = s
+	}
+	return w.w.Write(w.record)
+}
+
+// Flush writes any buffered data to the underlying writer and returns the
+// first error encountered.
+func (w *Writer[T]) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,118 @@
+package intx
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/48"
+	"github.com/CVDpl/go-intx/csvbind"
+
+	"testing"
+)
+
+type csvSample struct {
+	ID     Uint48     `csv:"id"`
+	Offset Int24      `csv:"offset"`
+	Parent NullUint48 `csv:"parent"`
+	Name   string
+	Seen   time.Time `csv:"seen"`
+	Score  float64   `csv:"score"`
+	Count  int16     `csv:"count"`
+	Note   string    `csv:"-"`
+}
+
+func TestCSVBindReadWrite(t *testing.T) {
+	input := "name,id,extra,offset,parent,seen,score,count\n" +
+		"alpha,281474976710655,x,-8388608,,2024-01-02T03:04:05Z,1.5,-7\n" +
+		"beta,1,y,42,1,2024-06-01T00:00:00Z,0,300\n"
+	r, err := csvbind.NewReader[csvSample](csv.NewReader(strings.NewReader(input)))
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+	rows, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("ReadAll() returned %d rows, want 2", len(rows))
+	}
+	a, b := rows[0], rows[1]
+	if a.Name != "alpha" || a.ID.Uint64() != 0xFFFFFFFFFFFF || a.Offset.Int64() != -8388608 ||
+		a.Parent.Valid || a.Score != 1.5 || a.Count != -7 ||
+		!a.Seen.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("row 1 = %+v", a)
+	}
+	if b.Name != "beta" || !b.Parent.Valid || b.Parent.Uint48.Uint64() != 1 || b.Count != 300 {
+		t.Errorf("row 2 = %+v", b)
+	}
+
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	w, err := csvbind.NewWriter[csvSample](cw)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	want := "id,offset,parent,Name,seen,score,count\n" +
+		"281474976710655,-8388608,,alpha,2024-01-02T03:04:05Z,1.5,-7\n" +
+		"1,42,1,beta,2024-06-01T00:00:00Z,0,300\n"
+	if buf.String() != want {
+		t.Errorf("Writer output =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	// The written file reads back to the same rows.
+	r2, _ := csvbind.NewReader[csvSample](csv.NewReader(&buf))
+	again, err := r2.ReadAll()
+	if err != nil || len(again) != 2 || again[0] != rows[0] || again[1] != rows[1] {
+		t.Errorf("round trip = %+v, %v", again, err)
+	}
+}
+
+func TestCSVBindErrors(t *testing.T) {
+	input := "ID,Offset\n1,2\n3,8388608\n"
+	r, err := csvbind.NewReader[csvSample](csv.NewReader(strings.NewReader(input)))
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+	if v, err := r.Read(); err != nil || v.ID.Uint64() != 1 {
+		t.Fatalf("Read() = %+v, %v", v, err)
+	}
+	_, err = r.Read()
+	var pe *csvbind.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Read() error = %v, want *ParseError", err)
+	}
+	if pe.Line != 3 || pe.Column != 2 || pe.Header != "Offset" || pe.Field != "Offset" || !errors.Is(err, ErrInt24OutOfRange) {
+		t.Errorf("ParseError = %+v", pe)
+	}
+	if want := `csvbind: line 3, column 2 ("Offset"): value exceeds range for Int24`; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	r, _ = csvbind.NewReader[csvSample](csv.NewReader(strings.NewReader("id\n-1\n")))
+	if _, err := r.Read(); !errors.Is(err, ErrUint48OutOfRange) {
+		t.Errorf("Read(-1) error = %v, want %v", err, ErrUint48OutOfRange)
+	}
+
+	if _, err := csvbind.NewReader[csvSample](csv.NewReader(strings.NewReader("id,ID\n"))); !errors.Is(err, csvbind.ErrDuplicateColumn) {
+		t.Errorf("NewReader(duplicate) error = %v, want %v", err, csvbind.ErrDuplicateColumn)
+	}
+	if _, err := csvbind.NewReader[int](csv.NewReader(strings.NewReader("a\n"))); err != csvbind.ErrNotStruct {
+		t.Errorf("NewReader[int]() error = %v, want %v", err, csvbind.ErrNotStruct)
+	}
+	type bad struct{ C chan int }
+	if _, err := csvbind.NewReader[bad](csv.NewReader(strings.NewReader("C\n"))); !errors.Is(err, csvbind.ErrUnsupportedType) {
+		t.Errorf("NewReader[bad]() error = %v, want %v", err, csvbind.ErrUnsupportedType)
+	}
+}
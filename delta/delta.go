@@ -0,0 +1,251 @@
+// Package delta compresses sequences of intx values as zigzag-encoded
+// varint deltas. Sorted or slowly changing sequences, such as ID lists and
+// timestamps, usually shrink to one or two bytes per value.
+//
+// Values are grouped into blocks. Each block starts with its first value in
+// full, so any block can be decoded on its own, and the stream carries a skip
+// index of block lengths for random access:
+//
+//	uvarint count
+//	uvarint block size
+//	uvarint length in bytes of each block, one per block
+//	blocks: zigzag varint first value, then zigzag varint deltas
+package delta
+
+import (
+	"encoding/binary"
+	"errors"
+	"iter"
+
+	"github.com/CVDpl/go-intx/internal/conv"
+)
+
+// Common errors for the delta package
+var (
+	ErrCorrupt          = errors.New("corrupt delta stream")
+	ErrInvalidBlockSize = errors.New("block size must be positive")
+	ErrIndexOutOfRange  = errors.New("index out of range")
+)
+
+// DefaultBlockSize is the number of values per block used by Encode.
+const DefaultBlockSize = 128
+
+// Value is the set of types that can be delta encoded.
+type Value = conv.Value
+
+// Encode compresses values using DefaultBlockSize.
+func Encode[T Value](values []T) []byte {
+	e, _ := NewEncoder[T](DefaultBlockSize)
+	e.Add(values...)
+	return e.Bytes()
+}
+
+// Decode decompresses a stream produced by Encode or an Encoder.
+func Decode[T Value](data []byte) ([]T, error) {
+	d, err := NewDecoder[T](data)
+	if err != nil {
+		return nil, err
+	}
+	return d.AppendAll(nil)
+}
+
+// Encoder accumulates values into a delta stream.
+type Encoder[T Value] struct {
+	conv      conv.Converter[T]
+	blockSize int
+	count     int
+	prev      int64
+	blocks    []byte
+	lengths   []int
+	start     int // offset of the current block in blocks
+}
+
+// NewEncoder returns an Encoder that starts a new block every blockSize values.
+func NewEncoder[T Value](blockSize int) (*Encoder[T], error) {
+	if blockSize <= 0 {
+		return nil, ErrInvalidBlockSize
+	}
+	return &Encoder[T]{conv: conv.For[T](), blockSize: blockSize}, nil
+}
+
+// Add appends values to the stream.
+func (e *Encoder[T]) Add(values ...T) {
+	for _, v := range values {
+		x := e.conv.ToInt(v)
+		if e.count%e.blockSize == 0 {
+			if e.count > 0 {
+				e.lengths = append(e.lengths, len(e.blocks)-e.start)
+			}
+			e.start = len(e.blocks)
+			e.blocks = binary.AppendVarint(e.blocks, x)
+		} else {
+			e.blocks = binary.AppendVarint(e.blocks, x-e.prev)
+		}
+		e.prev = x
+		e.count++
+	}
+}
+
+// Len returns the number of values added so far.
+func (e *Encoder[T]) Len() int { return e.count }
+
+// Bytes returns the encoded stream of all values added so far.
+func (e *Encoder[T]) Bytes() []byte {
+	lengths := e.lengths
+	if e.count > 0 {
+		lengths = append(lengths[:len(lengths):len(lengths)], len(e.blocks)-e.start)
+	}
+	// A stream with fewer values than a block is a single block, so its
+	// block size is written as the count; NewDecoder rejects larger ones.
+	blockSize := e.blockSize
+	if e.count > 0 {
+		blockSize = min(blockSize, e.count)
+	}
+	out := binary.AppendUvarint(nil, uint64(e.count))
+	out = binary.AppendUvarint(out, uint64(blockSize))
+	for _, n := range lengths {
+		out = binary.AppendUvarint(out, uint64(n))
+	}
+	return append(out, e.blocks...)
+}
+
+// Decoder reads values from a delta stream. It holds the skip index, so
+// At and Block decode only the block they need.
+type Decoder[T Value] struct {
+	conv      conv.Converter[T]
+	data      []byte
+	count     int
+	blockSize int
+	offsets   []int // start of each block in data, plus the end of the last block
+}
+
+// NewDecoder parses the header and skip index of data. A block size larger
+// than a non-zero count is rejected, so buffers sized by the block size are
+// bounded by the length of data.
+func NewDecoder[T Value](data []byte) (*Decoder[T], error) {
+	count, n := binary.Uvarint(data)
+	if n <= 0 || count > uint64(len(data)) {
+		return nil, ErrCorrupt
+	}
+	data = data[n:]
+	blockSize, n := binary.Uvarint(data)
+	if n <= 0 || blockSize == 0 || blockSize > 1<<31 || (count > 0 && blockSize > count) {
+		return nil, ErrCorrupt
+	}
+	data = data[n:]
+
+	numBlocks := (count + blockSize - 1) / blockSize
+	offsets := make([]int, numBlocks+1)
+	var total uint64
+	for i := range numBlocks {
+		length, n := binary.Uvarint(data)
+		if n <= 0 || length == 0 {
+			return nil, ErrCorrupt
+		}
+		data = data[n:]
+		total += length
+		if total > uint64(len(data)) {
+			return nil, ErrCorrupt
+		}
+		offsets[i+1] = int(total)
+	}
+	if total != uint64(len(data)) {
+		return nil, ErrCorrupt
+	}
+	return &Decoder[T]{
+		conv:      conv.For[T](),
+		data:      data,
+		count:     int(count),
+		blockSize: int(blockSize),
+		offsets:   offsets,
+	}, nil
+}
+
+// Len returns the number of values in the stream.
+func (d *Decoder[T]) Len() int { return d.count }
+
+// NumBlocks returns the number of blocks in the stream.
+func (d *Decoder[T]) NumBlocks() int { return len(d.offsets) - 1 }
+
+// Block appends the values of block i to dst. Decoded values are
+// range-checked against T.
+func (d *Decoder[T]) Block(i int, dst []T) ([]T, error) {
+	if i < 0 || i >= d.NumBlocks() {
+		return dst, ErrIndexOutOfRange
+	}
+	n := min(d.blockSize, d.count-i*d.blockSize)
+	err := d.walk(i, n, func(x int64) error {
+		v, err := d.conv.FromInt(x)
+		if err != nil {
+			return err
+		}
+		dst = append(dst, v)
+		return nil
+	})
+	return dst, err
+}
+
+// At returns the value at index i, decoding only the block that holds it.
+func (d *Decoder[T]) At(i int) (T, error) {
+	var v T
+	if i < 0 || i >= d.count {
+		return v, ErrIndexOutOfRange
+	}
+	var last int64
+	if err := d.walk(i/d.blockSize, i%d.blockSize+1, func(x int64) error {
+		last = x
+		return nil
+	}); err != nil {
+		return v, err
+	}
+	return d.conv.FromInt(last)
+}
+
+// AppendAll appends every value in the stream to dst.
+func (d *Decoder[T]) AppendAll(dst []T) ([]T, error) {
+	var err error
+	for i := 0; i < d.NumBlocks(); i++ {
+		if dst, err = d.Block(i, dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// All returns an iterator over the indexes and values of the stream. It
+// stops at the first corrupt or out-of-range value; use AppendAll to see
+// the error.
+func (d *Decoder[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		buf := make([]T, 0, min(d.blockSize, d.count))
+		for b := 0; b < d.NumBlocks(); b++ {
+			var err error
+			if buf, err = d.Block(b, buf[:0]); err != nil {
+				return
+			}
+			for j, v := range buf {
+				if !yield(b*d.blockSize+j, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// walk decodes the first n values of block b and passes each to f.
+func (d *Decoder[T]) walk(b, n int, f func(int64) error) error {
+	data := d.data[d.offsets[b]:d.offsets[b+1]]
+	var x int64
+	for j := 0; j < n; j++ {
+		delta, m := binary.Varint(data)
+		if m <= 0 {
+			return ErrCorrupt
+		}
+		data = data[m:]
+		x += delta
+		if err := f(x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package intx
+
+import (
+	"math/rand/v2"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/delta"
+
+	"testing"
+)
+
+func TestDeltaSortedUint48(t *testing.T) {
+	values := make([]Uint48, 1000)
+	x := uint64(0x100000000000)
+	for i := range values {
+		x += rand.Uint64N(100)
+		values[i] = MustUint48(x)
+	}
+
+	data := delta.Encode(values)
+	if len(data) > 2*len(values)+16 {
+		t.Errorf("Encode() produced %d bytes for %d sorted values", len(data), len(values))
+	}
+	got, err := delta.Decode[Uint48](data)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if len(got) != len(values) {
+		t.Fatalf("Decode() returned %d values, want %d", len(got), len(values))
+	}
+	for i := range values {
+		if got[i] != values[i] {
+			t.Fatalf("Decode()[%d] = %v, want %v", i, got[i], values[i])
+		}
+	}
+
+	d, err := delta.NewDecoder[Uint48](data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.NumBlocks() != 8 {
+		t.Errorf("NumBlocks() = %d, want 8", d.NumBlocks())
+	}
+	for _, i := range []int{0, 127, 128, 500, 999} {
+		if v, err := d.At(i); err != nil || v != values[i] {
+			t.Errorf("At(%d) = %v, %v, want %v", i, v, err, values[i])
+		}
+	}
+	if _, err := d.At(1000); err != delta.ErrIndexOutOfRange {
+		t.Errorf("At(1000) error = %v, want %v", err, delta.ErrIndexOutOfRange)
+	}
+	for i, v := range d.All() {
+		if v != values[i] {
+			t.Fatalf("All()[%d] = %v, want %v", i, v, values[i])
+		}
+	}
+}
+
+func TestDeltaSignedAndEdges(t *testing.T) {
+	values := []Int56{MustInt56(-0x80000000000000), MustInt56(0x7FFFFFFFFFFFFF), MustInt56(0), MustInt56(-1)}
+	e, err := delta.NewEncoder[Int56](3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Add(values...)
+	got, err := delta.Decode[Int56](e.Bytes())
+	if err != nil || len(got) != 4 {
+		t.Fatalf("Decode() = %v, %v", got, err)
+	}
+	for i := range values {
+		if got[i] != values[i] {
+			t.Errorf("Decode()[%d] = %v, want %v", i, got[i], values[i])
+		}
+	}
+
+	empty, err := delta.Decode[Uint40](delta.Encode[Uint40](nil))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Decode(empty) = %v, %v", empty, err)
+	}
+	if _, err := delta.NewEncoder[Uint40](0); err != delta.ErrInvalidBlockSize {
+		t.Errorf("NewEncoder(0) error = %v, want %v", err, delta.ErrInvalidBlockSize)
+	}
+}
+
+func TestDeltaRangeValidation(t *testing.T) {
+	data := delta.Encode([]Uint40{MustUint40(0xFFFFFFFFFF)})
+	if _, err := delta.Decode[Uint24](data); err != ErrUint24OutOfRange {
+		t.Errorf("Decode[Uint24]() error = %v, want %v", err, ErrUint24OutOfRange)
+	}
+
+	data = delta.Encode([]Int40{MustInt40(-1)})
+	if _, err := delta.Decode[Uint40](data); err != ErrUint40OutOfRange {
+		t.Errorf("Decode[Uint40]() error = %v, want %v", err, ErrUint40OutOfRange)
+	}
+
+	data = delta.Encode([]Int24{MustInt24(1), MustInt24(2)})
+	if _, err := delta.Decode[Int24](data[:len(data)-1]); err != delta.ErrCorrupt {
+		t.Errorf("Decode(truncated) error = %v, want %v", err, delta.ErrCorrupt)
+	}
+	if _, err := delta.Decode[Int48](nil); err != delta.ErrCorrupt {
+		t.Errorf("Decode(nil) error = %v, want %v", err, delta.ErrCorrupt)
+	}
+}
+
+// TestDeltaLargeBlockSize guards against buffers sized by a declared block
+// size that is far larger than the stream.
+func TestDeltaLargeBlockSize(t *testing.T) {
+	e, err := delta.NewEncoder[Uint40](1 << 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Add(MustUint40(7))
+	d, err := delta.NewDecoder[Uint40](e.Bytes())
+	if err != nil {
+		t.Fatalf("NewDecoder() error = %v", err)
+	}
+	n := 0
+	for i, v := range d.All() {
+		if i != 0 || v != MustUint40(7) {
+			t.Errorf("All() yielded %d, %v; want 0, 7", i, v)
+		}
+		n++
+	}
+	if n != 1 {
+		t.Errorf("All() yielded %d values, want 1", n)
+	}
+
+	// A hostile header: one value in blocks of 1<<30.
+	data := []byte{0x01, 0x80, 0x80, 0x80, 0x80, 0x04, 0x01, 0x0E}
+	if _, err := delta.NewDecoder[Uint40](data); err != delta.ErrCorrupt {
+		t.Errorf("NewDecoder(block size 1<<30, count 1) error = %v, want %v", err, delta.ErrCorrupt)
+	}
+}
@@ -0,0 +1,210 @@
+// Package der encodes and decodes intx values as ASN.1 DER INTEGERs: a tag
+// byte, a definite length and the value's minimal two's-complement bytes.
+//
+// Decoding is strict. It rejects non-minimal lengths and contents, as DER
+// requires, and range-checks the value into the target type, returning the
+// width package's ErrXOutOfRange error.
+//
+// SNMP and other protocols reuse the INTEGER encoding under implicit
+// application tags; AppendTagged and ParseTagged take the tag byte, and the
+// common SNMP tags are predefined.
+//
+// encoding/asn1 offers no marshaling hook for user-defined types, and it
+// rejects structs with unexported fields such as the intx types. To carry
+// an intx value in an encoding/asn1 struct, declare the field as
+// asn1.RawValue and convert with RawValue and FromRawValue:
+//
+//	type ifEntry struct {
+//		Index    int
+//		InOctets asn1.RawValue // Counter32, decoded as Uint40
+//	}
+//
+//	e.InOctets = der.TaggedRawValue(der.TagCounter32, octets)
+//	octets, err := der.FromRawValue[int40.Uint40](e.InOctets)
+package der
+
+import (
+	"encoding/asn1"
+	"errors"
+	"math"
+
+	"github.com/CVDpl/go-intx/internal/conv"
+)
+
+// Common errors for the der package
+var (
+	ErrTruncated     = errors.New("der: truncated data")
+	ErrUnexpectedTag = errors.New("der: unexpected tag")
+	ErrNonMinimal    = errors.New("der: non-minimal encoding")
+	ErrInvalidLength = errors.New("der: invalid length")
+	ErrConstructed   = errors.New("der: constructed value where primitive expected")
+)
+
+// Value is the set of intx types.
+type Value = conv.Value
+
+// Tags for INTEGER and the SNMP application types built on it (RFC 2578,
+// RFC 3416).
+const (
+	TagInteger   byte = 0x02 // UNIVERSAL 2
+	TagCounter32 byte = 0x41 // [APPLICATION 1] IMPLICIT INTEGER
+	TagGauge32   byte = 0x42 // [APPLICATION 2] IMPLICIT INTEGER
+	TagTimeTicks byte = 0x43 // [APPLICATION 3] IMPLICIT INTEGER
+	TagCounter64 byte = 0x46 // [APPLICATION 6] IMPLICIT INTEGER
+)
+
+// constructed is the bit of the identifier byte marking constructed encodings.
+const constructed = 0x20
+
+// AppendInteger appends the DER encoding of v as a universal INTEGER.
+func AppendInteger[T Value](b []byte, v T) []byte {
+	return AppendTagged(b, TagInteger, v)
+}
+
+// AppendTagged appends the DER encoding of v with the given single-byte
+// identifier in place of the INTEGER tag.
+func AppendTagged[T Value](b []byte, tag byte, v T) []byte {
+	x := conv.For[T]().ToInt(v)
+	n := contentLen(x)
+	b = append(b, tag, byte(n))
+	for i := n - 1; i >= 0; i-- {
+		b = append(b, byte(x>>(8*i)))
+	}
+	return b
+}
+
+// Size returns the number of bytes AppendInteger uses to encode v.
+func Size[T Value](v T) int {
+	return 2 + contentLen(conv.For[T]().ToInt(v))
+}
+
+// ParseInteger decodes a universal INTEGER from the start of data into T and
+// returns the remaining bytes.
+func ParseInteger[T Value](data []byte) (T, []byte, error) {
+	return ParseTagged[T](data, TagInteger)
+}
+
+// ParseTagged decodes an INTEGER encoded under the given single-byte
+// identifier from the start of data into T and returns the remaining bytes.
+func ParseTagged[T Value](data []byte, tag byte) (T, []byte, error) {
+	var zero T
+	if len(data) < 2 {
+		return zero, nil, ErrTruncated
+	}
+	if data[0] != tag {
+		return zero, nil, ErrUnexpectedTag
+	}
+	length, hdr, err := parseLength(data[1:])
+	if err != nil {
+		return zero, nil, err
+	}
+	data = data[1+hdr:]
+	if length > len(data) {
+		return zero, nil, ErrTruncated
+	}
+	v, err := Content[T](data[:length])
+	if err != nil {
+		return zero, nil, err
+	}
+	return v, data[length:], nil
+}
+
+// Content decodes the content octets of an INTEGER, without tag or length,
+// into T.
+func Content[T Value](content []byte) (T, error) {
+	c := conv.For[T]()
+	switch {
+	case len(content) == 0:
+		var zero T
+		return zero, ErrInvalidLength
+	case len(content) > 1 && (content[0] == 0x00 && content[1] < 0x80 ||
+		content[0] == 0xFF && content[1] >= 0x80):
+		var zero T
+		return zero, ErrNonMinimal
+	case len(content) > 8:
+		// Minimal and longer than an int64, so out of range of every intx
+		// type; let the type report it.
+		if content[0] >= 0x80 {
+			return c.FromInt(math.MinInt64)
+		}
+		return c.FromInt(math.MaxInt64)
+	}
+	x := int64(int8(content[0]))
+	for _, b := range content[1:] {
+		x = x<<8 | int64(b)
+	}
+	return c.FromInt(x)
+}
+
+// RawValue returns v as a universal INTEGER asn1.RawValue, for use in
+// encoding/asn1 structs.
+func RawValue[T Value](v T) asn1.RawValue {
+	return TaggedRawValue(TagInteger, v)
+}
+
+// TaggedRawValue returns v as an asn1.RawValue encoded under the given
+// single-byte identifier.
+func TaggedRawValue[T Value](tag byte, v T) asn1.RawValue {
+	full := AppendTagged(nil, tag, v)
+	return asn1.RawValue{
+		Class:     int(tag >> 6),
+		Tag:       int(tag & 0x1F),
+		Bytes:     full[2:],
+		FullBytes: full,
+	}
+}
+
+// FromRawValue decodes the content of a primitive asn1.RawValue into T.
+// Any class and tag number is accepted, so Counter32 and other implicitly
+// tagged integers decode the same as INTEGER; check rv.Class and rv.Tag to
+// be stricter.
+func FromRawValue[T Value](rv asn1.RawValue) (T, error) {
+	if rv.IsCompound {
+		var zero T
+		return zero, ErrConstructed
+	}
+	return Content[T](rv.Bytes)
+}
+
+// contentLen returns the number of content octets of x in minimal
+// two's-complement form.
+func contentLen(x int64) int {
+	n := 1
+	for x > math.MaxInt8 || x < math.MinInt8 {
+		n++
+		x >>= 8
+	}
+	return n
+}
+
+// parseLength decodes a definite DER length and returns it with the number
+// of bytes it occupies.
+func parseLength(data []byte) (int, int, error) {
+	if len(data) == 0 {
+		return 0, 0, ErrTruncated
+	}
+	first := data[0]
+	if first < 0x80 {
+		return int(first), 1, nil
+	}
+	n := int(first & 0x7F)
+	if n == 0 || n > 4 {
+		// 0x80 is the BER indefinite form; longer lengths cannot hold an
+		// INTEGER this package would accept.
+		return 0, 0, ErrInvalidLength
+	}
+	if len(data) < 1+n {
+		return 0, 0, ErrTruncated
+	}
+	if data[1] == 0 {
+		return 0, 0, ErrNonMinimal
+	}
+	length := 0
+	for _, b := range data[1 : 1+n] {
+		length = length<<8 | int(b)
+	}
+	if length < 0x80 {
+		return 0, 0, ErrNonMinimal
+	}
+	return length, 1 + n, nil
+}
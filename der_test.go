@@ -0,0 +1,137 @@
+package intx
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"math/big"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/der"
+
+	"testing"
+)
+
+func TestDERMatchesEncodingASN1(t *testing.T) {
+	for _, v := range []int64{0, 1, -1, 127, 128, -128, -129, 255, 256, 0x7FFFFF, -0x800000} {
+		got := der.AppendInteger(nil, MustInt24(v))
+		want, _ := asn1.Marshal(v)
+		if !bytes.Equal(got, want) {
+			t.Errorf("AppendInteger(%d) = % x, want % x", v, got, want)
+		}
+		if der.Size(MustInt24(v)) != len(want) {
+			t.Errorf("Size(%d) = %d, want %d", v, der.Size(MustInt24(v)), len(want))
+		}
+	}
+	for _, v := range []uint64{0, 0x80, 0xFFFFFF, 1<<40 - 1, 1<<56 - 1} {
+		got := der.AppendInteger(nil, MustUint56(v))
+		want, _ := asn1.Marshal(new(big.Int).SetUint64(v))
+		if !bytes.Equal(got, want) {
+			t.Errorf("AppendInteger(Uint56 %d) = % x, want % x", v, got, want)
+		}
+	}
+}
+
+func TestDERParse(t *testing.T) {
+	data := der.AppendInteger(nil, MustInt48(-1<<47))
+	data = append(data, 0xAA)
+	v, rest, err := der.ParseInteger[Int48](data)
+	if err != nil || v.Int64() != -1<<47 || !bytes.Equal(rest, []byte{0xAA}) {
+		t.Errorf("ParseInteger() = %v, % x, %v", v, rest, err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+		fn   func([]byte) error
+	}{
+		{"non-minimal zero pad", []byte{0x02, 0x02, 0x00, 0x7F}, der.ErrNonMinimal, parseAs[Int24]},
+		{"non-minimal sign pad", []byte{0x02, 0x02, 0xFF, 0x80}, der.ErrNonMinimal, parseAs[Int24]},
+		{"non-minimal length", []byte{0x02, 0x81, 0x01, 0x05}, der.ErrNonMinimal, parseAs[Int24]},
+		{"indefinite length", []byte{0x02, 0x80, 0x05, 0x00, 0x00}, der.ErrInvalidLength, parseAs[Int24]},
+		{"empty content", []byte{0x02, 0x00}, der.ErrInvalidLength, parseAs[Int24]},
+		{"truncated", []byte{0x02, 0x03, 0x01, 0x02}, der.ErrTruncated, parseAs[Int24]},
+		{"wrong tag", []byte{0x04, 0x01, 0x00}, der.ErrUnexpectedTag, parseAs[Int24]},
+		{"Int24 overflow", []byte{0x02, 0x04, 0x00, 0x80, 0x00, 0x00}, ErrInt24OutOfRange, parseAs[Int24]},
+		{"Uint24 negative", []byte{0x02, 0x01, 0xFF}, ErrUint24OutOfRange, parseAs[Uint24]},
+		{"Uint40 overflow", []byte{0x02, 0x06, 0x01, 0, 0, 0, 0, 0}, ErrUint40OutOfRange, parseAs[Uint40]},
+		{"Int56 overflow", []byte{0x02, 0x08, 0x80, 0, 0, 0, 0, 0, 0, 0}, ErrInt56OutOfRange, parseAs[Int56]},
+		{"Uint48 huge", []byte{0x02, 0x09, 0x01, 0, 0, 0, 0, 0, 0, 0, 0}, ErrUint48OutOfRange, parseAs[Uint48]},
+		{"Int40 huge negative", []byte{0x02, 0x09, 0x80, 0, 0, 0, 0, 0, 0, 0, 0}, ErrInt40OutOfRange, parseAs[Int40]},
+	}
+	for _, tt := range tests {
+		if err := tt.fn(tt.data); err != tt.want {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func parseAs[T der.Value](data []byte) error {
+	_, _, err := der.ParseInteger[T](data)
+	return err
+}
+
+func TestDERSNMPTags(t *testing.T) {
+	b := der.AppendTagged(nil, der.TagCounter32, MustUint40(0xFFFFFFFF))
+	if want := []byte{0x41, 0x05, 0x00, 0xFF, 0xFF, 0xFF, 0xFF}; !bytes.Equal(b, want) {
+		t.Errorf("AppendTagged(Counter32) = % x, want % x", b, want)
+	}
+	v, _, err := der.ParseTagged[Uint40](b, der.TagCounter32)
+	if err != nil || v.Uint64() != 0xFFFFFFFF {
+		t.Errorf("ParseTagged() = %v, %v", v, err)
+	}
+	if _, _, err := der.ParseInteger[Uint40](b); err != der.ErrUnexpectedTag {
+		t.Errorf("ParseInteger(Counter32) error = %v, want %v", err, der.ErrUnexpectedTag)
+	}
+}
+
+func TestDERWithEncodingASN1(t *testing.T) {
+	type ifEntry struct {
+		Index    int
+		Speed    asn1.RawValue
+		InOctets asn1.RawValue
+	}
+	in := ifEntry{
+		Index:    3,
+		Speed:    der.RawValue(MustUint48(10_000_000_000)),
+		InOctets: der.TaggedRawValue(der.TagCounter32, MustUint40(4000000000)),
+	}
+	data, err := asn1.Marshal(in)
+	if err != nil {
+		t.Fatalf("asn1.Marshal() error = %v", err)
+	}
+
+	var out ifEntry
+	if _, err := asn1.Unmarshal(data, &out); err != nil {
+		t.Fatalf("asn1.Unmarshal() error = %v", err)
+	}
+	speed, err := der.FromRawValue[Uint48](out.Speed)
+	if err != nil || speed.Uint64() != 10_000_000_000 {
+		t.Errorf("FromRawValue(Speed) = %v, %v", speed, err)
+	}
+	octets, err := der.FromRawValue[Uint40](out.InOctets)
+	if err != nil || octets.Uint64() != 4000000000 || out.InOctets.Class != asn1.ClassApplication || out.InOctets.Tag != 1 {
+		t.Errorf("FromRawValue(InOctets) = %v, %v (class %d, tag %d)", octets, err, out.InOctets.Class, out.InOctets.Tag)
+	}
+	if _, err := der.FromRawValue[Uint24](out.Speed); err != ErrUint24OutOfRange {
+		t.Errorf("FromRawValue[Uint24]() error = %v, want %v", err, ErrUint24OutOfRange)
+	}
+
+	// A plain int field decodes what der produced.
+	var n struct{ N int64 }
+	if _, err := asn1.Unmarshal(mustASN1(t, struct{ N asn1.RawValue }{der.RawValue(MustInt56(-5))}), &n); err != nil || n.N != -5 {
+		t.Errorf("asn1.Unmarshal(int64) = %d, %v", n.N, err)
+	}
+}
+
+func mustASN1(t *testing.T, v any) []byte {
+	t.Helper()
+	data, err := asn1.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -0,0 +1,4 @@
+// Package intx provides helpers that work across all of the fixed-width
+// integer packages (24, 40, 48 and 56 bits).
+// The integer types themselves live in the width-specific subpackages.
+package intx
@@ -0,0 +1,6 @@
+// Package packet holds records generated by intxgen-schema from
+// packet.schema. It is the generated counterpart of the README's network
+// protocol example.
+package packet
+
+//go:generate go run ../../cmd/intxgen-schema -o packet_gen.go packet.schema
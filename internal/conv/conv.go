@@ -0,0 +1,115 @@
+// Package conv maps the intx types to and from int64 for the codecs that
+// work on plain integers.
+package conv
+
+import (
+	"unsafe"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Value is the set of intx types.
+type Value interface {
+	int24.Int24 | int24.Uint24 | int40.Int40 | int40.Uint40 |
+		int48.Int48 | int48.Uint48 | int56.Int56 | int56.Uint56
+}
+
+// Converter maps T to and from int64. Every intx value fits in an int64;
+// FromInt range-checks through the width package's constructor and returns
+// its ErrXOutOfRange error.
+type Converter[T Value] struct {
+	ToInt   func(T) int64
+	FromInt func(int64) (T, error)
+}
+
+var (
+	int24Conv  = Converter[int24.Int24]{int24.Int24.Int64, int24.NewInt24}
+	uint24Conv = Converter[int24.Uint24]{
+		func(v int24.Uint24) int64 { return int64(v.Uint64()) },
+		func(x int64) (int24.Uint24, error) { return int24.NewUint24(uint64(x)) },
+	}
+	int40Conv  = Converter[int40.Int40]{int40.Int40.Int64, int40.NewInt40}
+	uint40Conv = Converter[int40.Uint40]{
+		func(v int40.Uint40) int64 { return int64(v.Uint64()) },
+		func(x int64) (int40.Uint40, error) { return int40.NewUint40(uint64(x)) },
+	}
+	int48Conv  = Converter[int48.Int48]{int48.Int48.Int64, int48.NewInt48}
+	uint48Conv = Converter[int48.Uint48]{
+		func(v int48.Uint48) int64 { return int64(v.Uint64()) },
+		func(x int64) (int48.Uint48, error) { return int48.NewUint48(uint64(x)) },
+	}
+	int56Conv  = Converter[int56.Int56]{int56.Int56.Int64, int56.NewInt56}
+	uint56Conv = Converter[int56.Uint56]{
+		func(v int56.Uint56) int64 { return int64(v.Uint64()) },
+		func(x int64) (int56.Uint56, error) { return int56.NewUint56(uint64(x)) },
+	}
+)
+
+// For returns the Converter for T. It does not allocate: the converters are
+// package variables passed through the interface by pointer.
+func For[T Value]() Converter[T] {
+	var zero T
+	var c any
+	switch any(zero).(type) {
+	case int24.Int24:
+		c = &int24Conv
+	case int24.Uint24:
+		c = &uint24Conv
+	case int40.Int40:
+		c = &int40Conv
+	case int40.Uint40:
+		c = &uint40Conv
+	case int48.Int48:
+		c = &int48Conv
+	case int48.Uint48:
+		c = &uint48Conv
+	case int56.Int56:
+		c = &int56Conv
+	case int56.Uint56:
+		c = &uint56Conv
+	}
+	return *c.(*Converter[T])
+}
+
+// Bounds returns the smallest and largest values of T.
+func Bounds[T Value]() (lo, hi int64) {
+	var zero T
+	switch any(zero).(type) {
+	case int24.Int24:
+		return -1 << 23, 1<<23 - 1
+	case int24.Uint24:
+		return 0, 1<<24 - 1
+	case int40.Int40:
+		return -1 << 39, 1<<39 - 1
+	case int40.Uint40:
+		return 0, 1<<40 - 1
+	case int48.Int48:
+		return -1 << 47, 1<<47 - 1
+	case int48.Uint48:
+		return 0, 1<<48 - 1
+	case int56.Int56:
+		return -1 << 55, 1<<55 - 1
+	default:
+		return 0, 1<<56 - 1
+	}
+}
+
+// Unchecked converts x to T without a range check, for codecs that have
+// already checked a whole block against Bounds. x must lie within
+// Bounds[T](); otherwise the result is not a valid T.
+//
+// Every intx type stores its value, sign-extended for the signed types, in
+// a single int32 or int64-sized field, so x is written directly into it.
+// TestConvUnchecked in the root package guards this assumption.
+func Unchecked[T Value](x int64) T {
+	var v T
+	if unsafe.Sizeof(v) == 4 {
+		*(*int32)(unsafe.Pointer(&v)) = int32(x)
+	} else {
+		*(*int64)(unsafe.Pointer(&v)) = x
+	}
+	return v
+}
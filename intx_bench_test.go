@@ -7,6 +7,8 @@ import (
 	. "github.com/CVDpl/go-intx/40"
 	. "github.com/CVDpl/go-intx/48"
 	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/bitpack"
+	"github.com/CVDpl/go-intx/pbwire"
 
 	"testing"
 )
@@ -563,3 +565,381 @@ func BenchmarkInt56String(b *testing.B) {
 		_ = intVar.String()
 	}
 }
+
+// Benchmark bulk encode/decode
+const bulkBenchLen = 4096
+
+func BenchmarkUint24ToBytesLoop(b *testing.B) {
+	src := make([]Uint24, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*3)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, v := range src {
+			bytes := v.ToBytes()
+			copy(dst[j*3:], bytes[:])
+		}
+	}
+}
+
+func BenchmarkEncodeUint24sBE(b *testing.B) {
+	src := make([]Uint24, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*3)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint24sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint24sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*3)
+	dst := make([]Uint24, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint24sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeUint24sLE(b *testing.B) {
+	src := make([]Uint24, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*3)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint24sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint24sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*3)
+	dst := make([]Uint24, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint24sLE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt24sBE(b *testing.B) {
+	src := make([]Int24, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*3)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt24sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt24sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*3)
+	dst := make([]Int24, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt24sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt24sLE(b *testing.B) {
+	src := make([]Int24, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*3)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt24sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt24sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*3)
+	dst := make([]Int24, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt24sLE(dst, src)
+	}
+}
+
+func BenchmarkEncodeUint40sBE(b *testing.B) {
+	src := make([]Uint40, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*5)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint40sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint40sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*5)
+	dst := make([]Uint40, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint40sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeUint40sLE(b *testing.B) {
+	src := make([]Uint40, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*5)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint40sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint40sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*5)
+	dst := make([]Uint40, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint40sLE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt40sBE(b *testing.B) {
+	src := make([]Int40, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*5)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt40sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt40sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*5)
+	dst := make([]Int40, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt40sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt40sLE(b *testing.B) {
+	src := make([]Int40, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*5)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt40sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt40sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*5)
+	dst := make([]Int40, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt40sLE(dst, src)
+	}
+}
+
+func BenchmarkEncodeUint48sBE(b *testing.B) {
+	src := make([]Uint48, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*6)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint48sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint48sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*6)
+	dst := make([]Uint48, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint48sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeUint48sLE(b *testing.B) {
+	src := make([]Uint48, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*6)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint48sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint48sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*6)
+	dst := make([]Uint48, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint48sLE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt48sBE(b *testing.B) {
+	src := make([]Int48, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*6)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt48sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt48sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*6)
+	dst := make([]Int48, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt48sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt48sLE(b *testing.B) {
+	src := make([]Int48, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*6)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt48sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt48sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*6)
+	dst := make([]Int48, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt48sLE(dst, src)
+	}
+}
+
+func BenchmarkEncodeUint56sBE(b *testing.B) {
+	src := make([]Uint56, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*7)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint56sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint56sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*7)
+	dst := make([]Uint56, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint56sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeUint56sLE(b *testing.B) {
+	src := make([]Uint56, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*7)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeUint56sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeUint56sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*7)
+	dst := make([]Uint56, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeUint56sLE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt56sBE(b *testing.B) {
+	src := make([]Int56, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*7)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt56sBE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt56sBE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*7)
+	dst := make([]Int56, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt56sBE(dst, src)
+	}
+}
+
+func BenchmarkEncodeInt56sLE(b *testing.B) {
+	src := make([]Int56, bulkBenchLen)
+	dst := make([]byte, bulkBenchLen*7)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeInt56sLE(dst, src)
+	}
+}
+
+func BenchmarkDecodeInt56sLE(b *testing.B) {
+	src := make([]byte, bulkBenchLen*7)
+	dst := make([]Int56, bulkBenchLen)
+	b.SetBytes(bulkBenchLen * 7)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeInt56sLE(dst, src)
+	}
+}
+
+// Benchmark frame-of-reference bit-packing
+func BenchmarkBitpackEncodeUint40(b *testing.B) {
+	src := make([]Uint40, bulkBenchLen)
+	for i := range src {
+		src[i] = MustUint40(0x8000000000 + uint64(i*37%4096))
+	}
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bitpack.Encode(src)
+	}
+}
+
+func BenchmarkBitpackDecodeUint40(b *testing.B) {
+	src := make([]Uint40, bulkBenchLen)
+	for i := range src {
+		src[i] = MustUint40(0x8000000000 + uint64(i*37%4096))
+	}
+	data := bitpack.Encode(src)
+	b.SetBytes(bulkBenchLen * 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bitpack.Decode[Uint40](data)
+	}
+}
+
+// Benchmark protobuf wire helpers
+func BenchmarkPBWireAppendSintInt48(b *testing.B) {
+	v := MustInt48(-123456789)
+	buf := make([]byte, 0, 16)
+	for i := 0; i < b.N; i++ {
+		buf = pbwire.AppendSint(buf[:0], v)
+	}
+}
+
+func BenchmarkPBWireConsumeSintInt48(b *testing.B) {
+	data := pbwire.AppendSint(nil, MustInt48(-123456789))
+	for i := 0; i < b.N; i++ {
+		pbwire.ConsumeSint[Int48](data)
+	}
+}
@@ -0,0 +1,232 @@
+package intx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Common errors for Layout
+var (
+	ErrFieldOutOfRange    = errors.New("field exceeds width of value")
+	ErrFieldOverlap       = errors.New("fields overlap")
+	ErrFieldValueOverflow = errors.New("value does not fit in field")
+	ErrFieldNotInLayout   = errors.New("field is not part of the layout")
+)
+
+// Unsigned is the set of unsigned intx types.
+type Unsigned interface {
+	int24.Uint24 | int40.Uint40 | int48.Uint48 | int56.Uint56
+	Uint64() uint64
+}
+
+// Field describes a bit field inside a value. Offset counts bits from the
+// least significant bit.
+type Field struct {
+	Name   string
+	Offset uint
+	Width  uint
+	Signed bool
+}
+
+// String returns a description such as "device[12:32] unsigned".
+func (f Field) String() string {
+	sign := "unsigned"
+	if f.Signed {
+		sign = "signed"
+	}
+	return f.Name + "[" + strconv.FormatUint(uint64(f.Offset), 10) + ":" + f.end() + "] " + sign
+}
+
+// end formats the bit offset just past f without wrapping around.
+func (f Field) end() string {
+	end, carry := bits.Add64(uint64(f.Offset), uint64(f.Width), 0)
+	if carry == 0 {
+		return strconv.FormatUint(end, 10)
+	}
+	x := new(big.Int).SetUint64(uint64(f.Offset))
+	return x.Add(x, new(big.Int).SetUint64(uint64(f.Width))).String()
+}
+
+// within reports whether f is non-empty and lies in the low width bits.
+// The comparison is arranged so that large offsets cannot wrap around.
+func (f Field) within(width uint) bool {
+	return f.Width != 0 && f.Offset < width && f.Width <= width-f.Offset
+}
+
+func (f Field) mask() uint64 { return (uint64(1)<<f.Width - 1) << f.Offset }
+
+// extract returns the raw bits of f in x.
+func (f Field) extract(x uint64) uint64 { return x & f.mask() >> f.Offset }
+
+// extractInt returns the bits of f in x sign-extended from the field width.
+func (f Field) extractInt(x uint64) int64 {
+	return int64(f.extract(x)<<(64-f.Width)) >> (64 - f.Width)
+}
+
+// Layout is a validated set of non-overlapping fields inside an unsigned
+// intx type T.
+type Layout[T Unsigned] struct {
+	fields []Field
+}
+
+// NewLayout validates fields and returns a Layout. Every field must have a
+// non-zero width, lie within the width of T and not overlap another field.
+func NewLayout[T Unsigned](fields ...Field) (Layout[T], error) {
+	width := bitWidth[T]()
+	var used uint64
+	for _, f := range fields {
+		if !f.within(width) {
+			return Layout[T]{}, fmt.Errorf("intx: field %s: %w", f.Name, ErrFieldOutOfRange)
+		}
+		if used&f.mask() != 0 {
+			return Layout[T]{}, fmt.Errorf("intx: field %s: %w", f.Name, ErrFieldOverlap)
+		}
+		used |= f.mask()
+	}
+	sorted := slices.Clone(fields)
+	slices.SortFunc(sorted, func(a, b Field) int { return int(b.Offset) - int(a.Offset) })
+	return Layout[T]{fields: sorted}, nil
+}
+
+// Fields returns the fields of the layout, most significant first.
+func (l Layout[T]) Fields() []Field { return slices.Clone(l.fields) }
+
+// Lookup returns the field with the given name.
+func (l Layout[T]) Lookup(name string) (Field, bool) {
+	for _, f := range l.fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// Extract returns the raw bits of f in v. f must be one of the layout's
+// fields; otherwise Extract returns ErrFieldOutOfRange or
+// ErrFieldNotInLayout.
+func (l Layout[T]) Extract(v T, f Field) (uint64, error) {
+	if err := l.check(f); err != nil {
+		return 0, err
+	}
+	return f.extract(v.Uint64()), nil
+}
+
+// ExtractInt returns the bits of f in v sign-extended from the field width.
+// f must be one of the layout's fields.
+func (l Layout[T]) ExtractInt(v T, f Field) (int64, error) {
+	if err := l.check(f); err != nil {
+		return 0, err
+	}
+	return f.extractInt(v.Uint64()), nil
+}
+
+// Insert returns v with the bits of f replaced by x. f must be one of the
+// layout's fields. It returns ErrFieldValueOverflow if x does not fit in the
+// field.
+func (l Layout[T]) Insert(v T, f Field, x uint64) (T, error) {
+	if err := l.check(f); err != nil {
+		return v, err
+	}
+	if x>>f.Width != 0 {
+		return v, ErrFieldValueOverflow
+	}
+	return fromUint64[T](v.Uint64()&^f.mask() | x<<f.Offset)
+}
+
+// InsertInt returns v with the bits of f replaced by the two's complement
+// form of x. f must be one of the layout's fields. It returns
+// ErrFieldValueOverflow if x does not fit in the field.
+func (l Layout[T]) InsertInt(v T, f Field, x int64) (T, error) {
+	if err := l.check(f); err != nil {
+		return v, err
+	}
+	lo, hi := int64(-1)<<(f.Width-1), int64(1)<<(f.Width-1)-1
+	if x < lo || x > hi {
+		return v, ErrFieldValueOverflow
+	}
+	return fromUint64[T](v.Uint64()&^f.mask() | (uint64(x)&(uint64(1)<<f.Width-1))<<f.Offset)
+}
+
+// check reports whether f lies within T and is one of the layout's fields.
+func (l Layout[T]) check(f Field) error {
+	if !f.within(bitWidth[T]()) {
+		return fmt.Errorf("intx: field %s: %w", f.Name, ErrFieldOutOfRange)
+	}
+	if !slices.Contains(l.fields, f) {
+		return fmt.Errorf("intx: field %s: %w", f.Name, ErrFieldNotInLayout)
+	}
+	return nil
+}
+
+// String lists the fields of the layout, most significant first.
+func (l Layout[T]) String() string {
+	parts := make([]string, len(l.fields))
+	for i, f := range l.fields {
+		parts[i] = f.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Dump returns one line per field with its bit range and decoded value.
+func (l Layout[T]) Dump(v T) string {
+	var sb strings.Builder
+	for _, f := range l.fields {
+		sb.WriteString(f.String())
+		sb.WriteString(" = ")
+		if f.Signed {
+			sb.WriteString(strconv.FormatInt(f.extractInt(v.Uint64()), 10))
+		} else {
+			sb.WriteString(strconv.FormatUint(f.extract(v.Uint64()), 10))
+		}
+		sb.WriteString(" (0x")
+		sb.WriteString(strconv.FormatUint(f.extract(v.Uint64()), 16))
+		sb.WriteString(")\n")
+	}
+	return sb.String()
+}
+
+// bitWidth returns the width of T in bits.
+func bitWidth[T Unsigned]() uint {
+	var zero T
+	switch any(zero).(type) {
+	case int24.Uint24:
+		return 24
+	case int40.Uint40:
+		return 40
+	case int48.Uint48:
+		return 48
+	default:
+		return 56
+	}
+}
+
+// fromUint64 converts x to T using the package constructor for T.
+func fromUint64[T Unsigned](x uint64) (T, error) {
+	var zero T
+	var v any
+	var err error
+	switch any(zero).(type) {
+	case int24.Uint24:
+		v, err = int24.NewUint24(x)
+	case int40.Uint40:
+		v, err = int40.NewUint40(x)
+	case int48.Uint48:
+		v, err = int48.NewUint48(x)
+	default:
+		v, err = int56.NewUint56(x)
+	}
+	if err != nil {
+		return zero, err
+	}
+	return v.(T), nil
+}
@@ -0,0 +1,111 @@
+package intx
+
+import (
+	"errors"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/48"
+
+	"testing"
+)
+
+func TestLayoutExtractInsert(t *testing.T) {
+	site := Field{Name: "site", Offset: 32, Width: 16}
+	device := Field{Name: "device", Offset: 12, Width: 20}
+	channel := Field{Name: "channel", Offset: 0, Width: 12, Signed: true}
+	l, err := NewLayout[Uint48](channel, site, device)
+	if err != nil {
+		t.Fatalf("NewLayout() error = %v", err)
+	}
+
+	v := MustUint48(0)
+	if v, err = l.Insert(v, site, 0xBEEF); err != nil {
+		t.Fatal(err)
+	}
+	if v, err = l.Insert(v, device, 0x12345); err != nil {
+		t.Fatal(err)
+	}
+	if v, err = l.InsertInt(v, channel, -5); err != nil {
+		t.Fatal(err)
+	}
+	if v.Uint64() != 0xBEEF12345FFB {
+		t.Fatalf("Insert() = %x, want beef12345ffb", v.Uint64())
+	}
+
+	if got, err := l.Extract(v, site); err != nil || got != 0xBEEF {
+		t.Errorf("Extract(site) = %x, %v, want beef", got, err)
+	}
+	if got, err := l.Extract(v, device); err != nil || got != 0x12345 {
+		t.Errorf("Extract(device) = %x, %v, want 12345", got, err)
+	}
+	if got, err := l.ExtractInt(v, channel); err != nil || got != -5 {
+		t.Errorf("ExtractInt(channel) = %v, %v, want -5", got, err)
+	}
+
+	if got, want := l.String(), "site[32:48] unsigned, device[12:32] unsigned, channel[0:12] signed"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	want := "site[32:48] unsigned = 48879 (0xbeef)\n" +
+		"device[12:32] unsigned = 74565 (0x12345)\n" +
+		"channel[0:12] signed = -5 (0xffb)\n"
+	if got := l.Dump(v); got != want {
+		t.Errorf("Dump() = %q, want %q", got, want)
+	}
+}
+
+func TestLayoutValidation(t *testing.T) {
+	if _, err := NewLayout[Uint24](Field{Name: "a", Offset: 16, Width: 9}); !errors.Is(err, ErrFieldOutOfRange) {
+		t.Errorf("NewLayout() error = %v, want %v", err, ErrFieldOutOfRange)
+	}
+	// Offset+Width wraps around to 1 in uint arithmetic.
+	huge := Field{Name: "huge", Offset: ^uint(0), Width: 2}
+	if _, err := NewLayout[Uint48](huge); !errors.Is(err, ErrFieldOutOfRange) {
+		t.Errorf("NewLayout(huge offset) error = %v, want %v", err, ErrFieldOutOfRange)
+	}
+	if got, want := huge.String(), "huge[18446744073709551615:18446744073709551617] unsigned"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if _, err := NewLayout[Uint24](Field{Name: "a", Offset: 0, Width: 8}, Field{Name: "b", Offset: 7, Width: 4}); !errors.Is(err, ErrFieldOverlap) {
+		t.Errorf("NewLayout() error = %v, want %v", err, ErrFieldOverlap)
+	}
+
+	f := Field{Name: "nibble", Offset: 4, Width: 4}
+	low := Field{Name: "low", Offset: 0, Width: 4, Signed: true}
+	l, err := NewLayout[Uint24](f, low)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Insert(MustUint24(0), f, 16); !errors.Is(err, ErrFieldValueOverflow) {
+		t.Errorf("Insert() error = %v, want %v", err, ErrFieldValueOverflow)
+	}
+	if _, err := l.InsertInt(MustUint24(0), low, 8); !errors.Is(err, ErrFieldValueOverflow) {
+		t.Errorf("InsertInt() error = %v, want %v", err, ErrFieldValueOverflow)
+	}
+	if _, err := l.Insert(MustUint24(0), Field{Offset: 20, Width: 8}, 1); !errors.Is(err, ErrFieldOutOfRange) {
+		t.Errorf("Insert() error = %v, want %v", err, ErrFieldOutOfRange)
+	}
+	if _, err := l.Insert(MustUint24(0), huge, 1); !errors.Is(err, ErrFieldOutOfRange) {
+		t.Errorf("Insert(huge offset) error = %v, want %v", err, ErrFieldOutOfRange)
+	}
+	if _, err := l.Extract(MustUint24(0), Field{Offset: ^uint(0) - 1, Width: 4}); !errors.Is(err, ErrFieldOutOfRange) {
+		t.Errorf("Extract(huge offset) error = %v, want %v", err, ErrFieldOutOfRange)
+	}
+
+	// Extract must not read bits past T or fields the layout does not hold.
+	v := MustUint24(0xABCDEF)
+	if got, err := l.Extract(v, Field{Name: "wide", Offset: 16, Width: 16}); !errors.Is(err, ErrFieldOutOfRange) {
+		t.Errorf("Extract(past width) = %x, %v, want %v", got, err, ErrFieldOutOfRange)
+	}
+	if got, err := l.ExtractInt(v, Field{Name: "wide", Offset: 20, Width: 40, Signed: true}); !errors.Is(err, ErrFieldOutOfRange) {
+		t.Errorf("ExtractInt(past width) = %v, %v, want %v", got, err, ErrFieldOutOfRange)
+	}
+	if got, err := l.Extract(v, Field{Name: "other", Offset: 8, Width: 8}); !errors.Is(err, ErrFieldNotInLayout) {
+		t.Errorf("Extract(foreign field) = %x, %v, want %v", got, err, ErrFieldNotInLayout)
+	}
+	if _, err := l.Insert(v, Field{Name: "nibble", Offset: 4, Width: 3}, 1); !errors.Is(err, ErrFieldNotInLayout) {
+		t.Errorf("Insert(foreign field) error = %v, want %v", err, ErrFieldNotInLayout)
+	}
+	if got, err := l.ExtractInt(v, low); err != nil || got != -1 {
+		t.Errorf("ExtractInt(low) = %v, %v, want -1", got, err)
+	}
+}
@@ -0,0 +1,669 @@
+package intx
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Common errors for Marshal and Unmarshal
+var (
+	ErrUnsupportedType = errors.New("unsupported type")
+	ErrInvalidTag      = errors.New("invalid intx struct tag")
+	ErrShortData       = errors.New("data too short")
+	ErrTrailingData    = errors.New("trailing data after value")
+	ErrLengthMismatch  = errors.New("slice length does not match its length field")
+	ErrNilPointer      = errors.New("unmarshal target must be a non-nil pointer")
+)
+
+// Marshal returns the wire encoding of v, which must be a struct, array,
+// fixed-size native integer, intx type, or a pointer to one of these.
+//
+// Intx types and their packed forms are written at their wire width (3, 5,
+// 6 or 7 bytes), native integers, floats and bools at their binary.Write
+// size, arrays and structs element by element with no padding. order selects the default byte order
+// and may be overridden per field with struct tags:
+//
+//	intx:"le"        encode the field (and everything inside it) little-endian
+//	intx:"be"        encode the field (and everything inside it) big-endian
+//	intx:"skip"      ignore the field; "-" is accepted as well
+//	intx:"len=Count" encode a slice whose length is held in the earlier
+//	                 integer field Count
+//
+// Options may be combined with commas, e.g. intx:"le,len=Count". Slices
+// without a len option and unexported fields are not encoded. A nil order
+// means big-endian.
+func Marshal(v any, order binary.ByteOrder) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, ErrNilPointer
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil, ErrUnsupportedType
+	}
+	c, err := codecFor(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	if !rv.CanAddr() {
+		p := reflect.New(rv.Type())
+		p.Elem().Set(rv)
+		rv = p.Elem()
+	}
+	return c.encode(nil, rv, isLittleEndian(order))
+}
+
+// Unmarshal decodes data into the value pointed to by v using the same
+// layout rules as Marshal, with big-endian as the default byte order; le
+// and be struct tags still apply. Decoded intx values are range-checked by
+// the packages' From*Bytes functions. data must be consumed exactly.
+//
+// Data produced by Marshal with another default order is decoded with
+// UnmarshalOrder.
+func Unmarshal(data []byte, v any) error {
+	return UnmarshalOrder(data, v, binary.BigEndian)
+}
+
+// UnmarshalOrder is like Unmarshal but uses order as the default byte
+// order, mirroring the order argument of Marshal. A nil order means
+// big-endian.
+func UnmarshalOrder(data []byte, v any, order binary.ByteOrder) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return ErrNilPointer
+	}
+	rv = rv.Elem()
+	c, err := codecFor(rv.Type())
+	if err != nil {
+		return err
+	}
+	d := decodeState{data: data}
+	if err := c.decode(&d, rv, isLittleEndian(order)); err != nil {
+		return err
+	}
+	if len(d.data) != 0 {
+		return ErrTrailingData
+	}
+	return nil
+}
+
+func isLittleEndian(order binary.ByteOrder) bool {
+	return order != nil && order.Uint16([]byte{1, 0}) == 1
+}
+
+// decodeState holds the bytes that remain to be decoded.
+type decodeState struct {
+	data []byte
+}
+
+func (d *decodeState) next(n int) ([]byte, error) {
+	if len(d.data) < n {
+		return nil, ErrShortData
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b, nil
+}
+
+// A codec encodes and decodes values of one Go type. Values passed to a
+// codec are always addressable.
+type codec interface {
+	encode(buf []byte, v reflect.Value, little bool) ([]byte, error)
+	decode(d *decodeState, v reflect.Value, little bool) error
+}
+
+// codecCache maps reflect.Type to the codec compiled for it.
+var codecCache sync.Map
+
+func codecFor(t reflect.Type) (codec, error) {
+	if c, ok := codecCache.Load(t); ok {
+		return c.(codec), nil
+	}
+	c, err := compileCodec(t, map[reflect.Type]*structCodec{})
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := codecCache.LoadOrStore(t, c)
+	return actual.(codec), nil
+}
+
+func compileCodec(t reflect.Type, building map[reflect.Type]*structCodec) (codec, error) {
+	if c, ok := intxCodecs[t]; ok {
+		return c, nil
+	}
+	if packedTypes[t] {
+		return packedCodec{size: t.Len()}, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int8, reflect.Uint8:
+		return nativeCodec{kind: t.Kind(), size: 1}, nil
+	case reflect.Int16, reflect.Uint16:
+		return nativeCodec{kind: t.Kind(), size: 2}, nil
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return nativeCodec{kind: t.Kind(), size: 4}, nil
+	case reflect.Int64, reflect.Uint64, reflect.Float64:
+		return nativeCodec{kind: t.Kind(), size: 8}, nil
+	case reflect.Array:
+		elem, err := compileCodec(t.Elem(), building)
+		if err != nil {
+			return nil, err
+		}
+		return arrayCodec{elem: elem, n: t.Len()}, nil
+	case reflect.Struct:
+		return compileStruct(t, building)
+	}
+	return nil, fmt.Errorf("intx: %v: %w", t, ErrUnsupportedType)
+}
+
+// Per-field byte order overrides selected by struct tags.
+const (
+	orderInherit = iota
+	orderBig
+	orderLittle
+)
+
+// fieldPlan describes how one struct field is encoded.
+type fieldPlan struct {
+	index    int
+	name     string
+	codec    codec
+	order    int // one of orderInherit, orderBig, orderLittle
+	lenIndex int // index of the length field for slices, -1 otherwise
+}
+
+type structCodec struct {
+	fields []fieldPlan
+}
+
+func compileStruct(t reflect.Type, building map[reflect.Type]*structCodec) (codec, error) {
+	if c, ok := building[t]; ok {
+		return c, nil
+	}
+	sc := &structCodec{}
+	building[t] = sc
+
+	indexByName := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		plan := fieldPlan{index: i, name: f.Name, lenIndex: -1}
+		lenField := ""
+		skip := false
+		if tag, ok := f.Tag.Lookup("intx"); ok && tag != "" {
+			for _, opt := range strings.Split(tag, ",") {
+				switch {
+				case opt == "skip" || opt == "-":
+					skip = true
+				case opt == "be":
+					plan.order = orderBig
+				case opt == "le":
+					plan.order = orderLittle
+				case strings.HasPrefix(opt, "len="):
+					lenField = strings.TrimPrefix(opt, "len=")
+				default:
+					return nil, fmt.Errorf("intx: field %s: %w %q", f.Name, ErrInvalidTag, tag)
+				}
+			}
+		}
+		if skip {
+			continue
+		}
+
+		if f.Type.Kind() == reflect.Slice {
+			if lenField == "" {
+				continue
+			}
+			idx, ok := indexByName[lenField]
+			if !ok || !isLengthType(t.Field(idx).Type) {
+				return nil, fmt.Errorf("intx: field %s: %w: length field %q must be an earlier integer field", f.Name, ErrInvalidTag, lenField)
+			}
+			elem, err := compileCodec(f.Type.Elem(), building)
+			if err != nil {
+				return nil, fmt.Errorf("intx: field %s: %w", f.Name, err)
+			}
+			plan.codec = sliceCodec{elem: elem}
+			plan.lenIndex = idx
+		} else {
+			if lenField != "" {
+				return nil, fmt.Errorf("intx: field %s: %w: len option requires a slice", f.Name, ErrInvalidTag)
+			}
+			c, err := compileCodec(f.Type, building)
+			if err != nil {
+				return nil, fmt.Errorf("intx: field %s: %w", f.Name, err)
+			}
+			plan.codec = c
+		}
+		indexByName[f.Name] = i
+		sc.fields = append(sc.fields, plan)
+	}
+	return sc, nil
+}
+
+func (c *structCodec) encode(buf []byte, v reflect.Value, little bool) ([]byte, error) {
+	var err error
+	for _, f := range c.fields {
+		fv := v.Field(f.index)
+		if f.lenIndex >= 0 {
+			n, _ := lengthValue(v.Field(f.lenIndex))
+			if n != uint64(fv.Len()) {
+				return nil, fmt.Errorf("intx: field %s: %w", f.name, ErrLengthMismatch)
+			}
+		}
+		buf, err = f.codec.encode(buf, fv, fieldOrder(f.order, little))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+func (c *structCodec) decode(d *decodeState, v reflect.Value, little bool) error {
+	for _, f := range c.fields {
+		fv := v.Field(f.index)
+		if f.lenIndex >= 0 {
+			n, ok := lengthValue(v.Field(f.lenIndex))
+			if !ok || n > uint64(len(d.data)) {
+				return fmt.Errorf("intx: field %s: %w", f.name, ErrShortData)
+			}
+			fv.Set(reflect.MakeSlice(fv.Type(), int(n), int(n)))
+		}
+		if err := f.codec.decode(d, fv, fieldOrder(f.order, little)); err != nil {
+			return fmt.Errorf("intx: field %s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+func fieldOrder(order int, little bool) bool {
+	switch order {
+	case orderBig:
+		return false
+	case orderLittle:
+		return true
+	}
+	return little
+}
+
+type arrayCodec struct {
+	elem codec
+	n    int
+}
+
+func (c arrayCodec) encode(buf []byte, v reflect.Value, little bool) ([]byte, error) {
+	var err error
+	for i := 0; i < c.n; i++ {
+		if buf, err = c.elem.encode(buf, v.Index(i), little); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+func (c arrayCodec) decode(d *decodeState, v reflect.Value, little bool) error {
+	for i := 0; i < c.n; i++ {
+		if err := c.elem.decode(d, v.Index(i), little); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sliceCodec encodes the elements of a slice without a length; the length is
+// carried by a separate field.
+type sliceCodec struct {
+	elem codec
+}
+
+func (c sliceCodec) encode(buf []byte, v reflect.Value, little bool) ([]byte, error) {
+	var err error
+	for i := 0; i < v.Len(); i++ {
+		if buf, err = c.elem.encode(buf, v.Index(i), little); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+func (c sliceCodec) decode(d *decodeState, v reflect.Value, little bool) error {
+	for i := 0; i < v.Len(); i++ {
+		if err := c.elem.decode(d, v.Index(i), little); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nativeCodec encodes bools, fixed-size integers and floats the same way
+// encoding/binary does.
+type nativeCodec struct {
+	kind reflect.Kind
+	size int
+}
+
+func (c nativeCodec) encode(buf []byte, v reflect.Value, little bool) ([]byte, error) {
+	var x uint64
+	switch c.kind {
+	case reflect.Bool:
+		if v.Bool() {
+			x = 1
+		}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x = uint64(v.Int())
+	case reflect.Float32:
+		x = uint64(math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		x = math.Float64bits(v.Float())
+	default:
+		x = v.Uint()
+	}
+	var tmp [8]byte
+	putUint(tmp[:c.size], x, little)
+	return append(buf, tmp[:c.size]...), nil
+}
+
+func (c nativeCodec) decode(d *decodeState, v reflect.Value, little bool) error {
+	b, err := d.next(c.size)
+	if err != nil {
+		return err
+	}
+	x := getUint(b, little)
+	switch c.kind {
+	case reflect.Bool:
+		v.SetBool(x != 0)
+	case reflect.Int8:
+		v.SetInt(int64(int8(x)))
+	case reflect.Int16:
+		v.SetInt(int64(int16(x)))
+	case reflect.Int32:
+		v.SetInt(int64(int32(x)))
+	case reflect.Int64:
+		v.SetInt(int64(x))
+	case reflect.Float32:
+		v.SetFloat(float64(math.Float32frombits(uint32(x))))
+	case reflect.Float64:
+		v.SetFloat(math.Float64frombits(x))
+	default:
+		v.SetUint(x)
+	}
+	return nil
+}
+
+func putUint(b []byte, x uint64, little bool) {
+	for i := range b {
+		if little {
+			b[i] = byte(x >> (8 * i))
+		} else {
+			b[len(b)-1-i] = byte(x >> (8 * i))
+		}
+	}
+}
+
+func getUint(b []byte, little bool) uint64 {
+	var x uint64
+	for i := range b {
+		if little {
+			x |= uint64(b[i]) << (8 * i)
+		} else {
+			x |= uint64(b[len(b)-1-i]) << (8 * i)
+		}
+	}
+	return x
+}
+
+// intxCodec encodes one of the intx types at its wire width.
+type intxCodec struct {
+	size int
+	put  func(dst []byte, v reflect.Value, little bool)
+	get  func(src []byte, v reflect.Value, little bool) error
+}
+
+func newIntxCodec[T any](size int, put func(dst []byte, x T, little bool), get func(src []byte, little bool) (T, error)) *intxCodec {
+	return &intxCodec{
+		size: size,
+		put: func(dst []byte, v reflect.Value, little bool) {
+			put(dst, *v.Addr().Interface().(*T), little)
+		},
+		get: func(src []byte, v reflect.Value, little bool) error {
+			x, err := get(src, little)
+			if err != nil {
+				return err
+			}
+			*v.Addr().Interface().(*T) = x
+			return nil
+		},
+	}
+}
+
+func (c *intxCodec) encode(buf []byte, v reflect.Value, little bool) ([]byte, error) {
+	var tmp [8]byte
+	c.put(tmp[:c.size], v, little)
+	return append(buf, tmp[:c.size]...), nil
+}
+
+func (c *intxCodec) decode(d *decodeState, v reflect.Value, little bool) error {
+	b, err := d.next(c.size)
+	if err != nil {
+		return err
+	}
+	return c.get(b, v, little)
+}
+
+var intxCodecs = map[reflect.Type]*intxCodec{
+	reflect.TypeFor[int24.Int24](): newIntxCodec(3,
+		func(dst []byte, x int24.Int24, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int24.Int24, error) {
+			if little {
+				return int24.FromInt24LittleEndianBytes(src)
+			}
+			return int24.FromInt24Bytes(src)
+		}),
+	reflect.TypeFor[int24.Uint24](): newIntxCodec(3,
+		func(dst []byte, x int24.Uint24, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int24.Uint24, error) {
+			if little {
+				return int24.FromUint24LittleEndianBytes(src)
+			}
+			return int24.FromUint24Bytes(src)
+		}),
+	reflect.TypeFor[int40.Int40](): newIntxCodec(5,
+		func(dst []byte, x int40.Int40, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int40.Int40, error) {
+			if little {
+				return int40.FromInt40LittleEndianBytes(src)
+			}
+			return int40.FromInt40Bytes(src)
+		}),
+	reflect.TypeFor[int40.Uint40](): newIntxCodec(5,
+		func(dst []byte, x int40.Uint40, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int40.Uint40, error) {
+			if little {
+				return int40.FromUint40LittleEndianBytes(src)
+			}
+			return int40.FromUint40Bytes(src)
+		}),
+	reflect.TypeFor[int48.Int48](): newIntxCodec(6,
+		func(dst []byte, x int48.Int48, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int48.Int48, error) {
+			if little {
+				return int48.FromInt48LittleEndianBytes(src)
+			}
+			return int48.FromInt48Bytes(src)
+		}),
+	reflect.TypeFor[int48.Uint48](): newIntxCodec(6,
+		func(dst []byte, x int48.Uint48, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int48.Uint48, error) {
+			if little {
+				return int48.FromUint48LittleEndianBytes(src)
+			}
+			return int48.FromUint48Bytes(src)
+		}),
+	reflect.TypeFor[int56.Int56](): newIntxCodec(7,
+		func(dst []byte, x int56.Int56, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int56.Int56, error) {
+			if little {
+				return int56.FromInt56LittleEndianBytes(src)
+			}
+			return int56.FromInt56Bytes(src)
+		}),
+	reflect.TypeFor[int56.Uint56](): newIntxCodec(7,
+		func(dst []byte, x int56.Uint56, little bool) {
+			if little {
+				b := x.ToLittleEndianBytes()
+				copy(dst, b[:])
+				return
+			}
+			b := x.ToBytes()
+			copy(dst, b[:])
+		},
+		func(src []byte, little bool) (int56.Uint56, error) {
+			if little {
+				return int56.FromUint56LittleEndianBytes(src)
+			}
+			return int56.FromUint56Bytes(src)
+		}),
+}
+
+// packedTypes holds the packed intx types, which are big-endian byte arrays
+// in memory but follow the selected byte order on the wire.
+var packedTypes = map[reflect.Type]bool{
+	reflect.TypeFor[int24.PackedInt24]():  true,
+	reflect.TypeFor[int24.PackedUint24](): true,
+	reflect.TypeFor[int40.PackedInt40]():  true,
+	reflect.TypeFor[int40.PackedUint40](): true,
+	reflect.TypeFor[int48.PackedInt48]():  true,
+	reflect.TypeFor[int48.PackedUint48](): true,
+	reflect.TypeFor[int56.PackedInt56]():  true,
+	reflect.TypeFor[int56.PackedUint56](): true,
+}
+
+// packedCodec encodes a packed intx type at its wire width.
+type packedCodec struct {
+	size int
+}
+
+func (c packedCodec) encode(buf []byte, v reflect.Value, little bool) ([]byte, error) {
+	b := v.Bytes()
+	if !little {
+		return append(buf, b...), nil
+	}
+	for i := len(b) - 1; i >= 0; i-- {
+		buf = append(buf, b[i])
+	}
+	return buf, nil
+}
+
+func (c packedCodec) decode(d *decodeState, v reflect.Value, little bool) error {
+	src, err := d.next(c.size)
+	if err != nil {
+		return err
+	}
+	b := v.Bytes()
+	if !little {
+		copy(b, src)
+		return nil
+	}
+	for i := range b {
+		b[i] = src[len(src)-1-i]
+	}
+	return nil
+}
+
+func isLengthType(t reflect.Type) bool {
+	if _, ok := intxCodecs[t]; ok || packedTypes[t] {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// lengthValue returns the value of a length field, reporting false for
+// negative values.
+func lengthValue(v reflect.Value) (uint64, bool) {
+	switch x := v.Interface().(type) {
+	case interface{ Uint64() uint64 }:
+		return x.Uint64(), true
+	case interface{ Int64() int64 }:
+		n := x.Int64()
+		return uint64(n), n >= 0
+	}
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := v.Int()
+		return uint64(n), n >= 0
+	}
+	return v.Uint(), true
+}
@@ -0,0 +1,142 @@
+package intx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+
+	"testing"
+)
+
+type testHeader struct {
+	Magic   Uint24
+	Version uint8
+	Offset  Int48 `intx:"le"`
+	Length  Uint40
+	Count   uint16
+	Items   []Int24 `intx:"len=Count"`
+	Pad     [2]uint8
+	Inner   testInner
+	Note    string `intx:"skip"`
+	hidden  int
+}
+
+type testInner struct {
+	ID    Uint48
+	Delta int32
+}
+
+func TestMarshalStruct(t *testing.T) {
+	h := testHeader{
+		Magic:   MustUint24(0x010203),
+		Version: 7,
+		Offset:  MustInt48(-2),
+		Length:  MustUint40(0x0A0B0C0D0E),
+		Count:   2,
+		Items:   []Int24{MustInt24(1), MustInt24(-1)},
+		Pad:     [2]uint8{0xEE, 0xFF},
+		Inner:   testInner{ID: MustUint48(0x112233445566), Delta: -3},
+		Note:    "ignored",
+	}
+
+	data, err := Marshal(&h, binary.BigEndian)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	expected := []byte{
+		0x01, 0x02, 0x03, // Magic
+		0x07,                               // Version
+		0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, // Offset (little-endian)
+		0x0A, 0x0B, 0x0C, 0x0D, 0x0E, // Length
+		0x00, 0x02, // Count
+		0x00, 0x00, 0x01, 0xFF, 0xFF, 0xFF, // Items
+		0xEE, 0xFF, // Pad
+		0x11, 0x22, 0x33, 0x44, 0x55, 0x66, // Inner.ID
+		0xFF, 0xFF, 0xFF, 0xFD, // Inner.Delta
+	}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("Marshal() = %x, want %x", data, expected)
+	}
+
+	var got testHeader
+	if err := Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	h.Note = ""
+	if got.Magic != h.Magic || got.Version != h.Version || got.Offset != h.Offset ||
+		got.Length != h.Length || got.Count != h.Count || len(got.Items) != 2 ||
+		got.Items[0] != h.Items[0] || got.Items[1] != h.Items[1] ||
+		got.Pad != h.Pad || got.Inner != h.Inner || got.Note != "" {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, h)
+	}
+}
+
+func TestMarshalLittleEndian(t *testing.T) {
+	v := struct {
+		A Uint24
+		B uint16
+		C Int40 `intx:"be"`
+	}{MustUint24(0x010203), 0x0405, MustInt40(1)}
+
+	data, err := Marshal(v, binary.LittleEndian)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	expected := []byte{0x03, 0x02, 0x01, 0x05, 0x04, 0x00, 0x00, 0x00, 0x00, 0x01}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("Marshal() = %x, want %x", data, expected)
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	type withLen struct {
+		N    uint8
+		Vals []Uint24 `intx:"len=N"`
+	}
+	if _, err := Marshal(withLen{N: 3, Vals: make([]Uint24, 2)}, nil); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Marshal() error = %v, want %v", err, ErrLengthMismatch)
+	}
+
+	type badLen struct {
+		Vals []Uint24 `intx:"len=N"`
+		N    uint8
+	}
+	if _, err := Marshal(badLen{}, nil); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("Marshal() error = %v, want %v", err, ErrInvalidTag)
+	}
+
+	type badTag struct {
+		A Uint24 `intx:"middle"`
+	}
+	if _, err := Marshal(badTag{}, nil); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("Marshal() error = %v, want %v", err, ErrInvalidTag)
+	}
+
+	type unsupported struct {
+		A int
+	}
+	if _, err := Marshal(unsupported{}, nil); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("Marshal() error = %v, want %v", err, ErrUnsupportedType)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	type rec struct {
+		N    uint8
+		Vals []Uint24 `intx:"len=N"`
+	}
+	var r rec
+	if err := Unmarshal([]byte{0x02, 0x00, 0x00, 0x01}, &r); !errors.Is(err, ErrShortData) {
+		t.Errorf("Unmarshal() error = %v, want %v", err, ErrShortData)
+	}
+	if err := Unmarshal([]byte{0x00, 0x00}, &r); !errors.Is(err, ErrTrailingData) {
+		t.Errorf("Unmarshal() error = %v, want %v", err, ErrTrailingData)
+	}
+	if err := Unmarshal([]byte{0x00}, r); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Unmarshal() error = %v, want %v", err, ErrNilPointer)
+	}
+}
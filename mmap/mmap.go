@@ -0,0 +1,157 @@
+// Package mmap provides read-only, memory-mapped arrays of packed intx values.
+// A file of back-to-back 3, 5, 6 or 7-byte values is mapped into memory and
+// read in place, without copying the records into 8-byte structs.
+package mmap
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"iter"
+	"os"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// Common errors for the mmap package
+var (
+	ErrIndexOutOfRange = errors.New("index out of range")
+	ErrClosed          = errors.New("array is closed")
+)
+
+// view is the read side of the width packages' slice containers.
+type view[T any] interface {
+	Len() int
+	At(i int) T
+}
+
+// Array is a read-only view of a memory-mapped file of packed values.
+// It must be closed to release the mapping; values returned before Close
+// remain valid, but the Array itself may not be used afterwards.
+type Array[T any] struct {
+	data    []byte
+	view    view[T]
+	compare func(a, b T) int
+}
+
+func open[T any, S view[T]](path string, order binary.ByteOrder, fromBytes func([]byte, binary.ByteOrder) (S, error), compare func(a, b T) int) (*Array[T], error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	data, err := mapFile(f, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	v, err := fromBytes(data, order)
+	if err != nil {
+		unmapFile(data)
+		return nil, err
+	}
+	return &Array[T]{data: data, view: v, compare: compare}, nil
+}
+
+// Len returns the number of values in the array, or 0 after Close.
+func (a *Array[T]) Len() int {
+	if a.view == nil {
+		return 0
+	}
+	return a.view.Len()
+}
+
+// At returns the value at index i.
+func (a *Array[T]) At(i int) (T, error) {
+	var zero T
+	if a.view == nil {
+		return zero, ErrClosed
+	}
+	if i < 0 || i >= a.view.Len() {
+		return zero, ErrIndexOutOfRange
+	}
+	return a.view.At(i), nil
+}
+
+// All returns an iterator over the indexes and values of the array.
+func (a *Array[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < a.Len(); i++ {
+			if !yield(i, a.view.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Search returns the smallest index i at which the value is >= v, and
+// whether the value at i equals v. The array must be sorted in ascending order.
+func (a *Array[T]) Search(v T) (int, bool) {
+	lo, hi := 0, a.Len()
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if a.compare(a.view.At(mid), v) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < a.Len() && a.compare(a.view.At(lo), v) == 0
+}
+
+// Close unmaps the file.
+func (a *Array[T]) Close() error {
+	if a.view == nil {
+		return ErrClosed
+	}
+	data := a.data
+	a.data, a.view = nil, nil
+	return unmapFile(data)
+}
+
+// OpenInt24 maps a file of 3-byte Int24 values in the given byte order.
+func OpenInt24(path string, order binary.ByteOrder) (*Array[int24.Int24], error) {
+	return open(path, order, int24.Int24SliceFromBytes, func(a, b int24.Int24) int { return cmp.Compare(a.Int64(), b.Int64()) })
+}
+
+// OpenUint24 maps a file of 3-byte Uint24 values in the given byte order.
+func OpenUint24(path string, order binary.ByteOrder) (*Array[int24.Uint24], error) {
+	return open(path, order, int24.Uint24SliceFromBytes, func(a, b int24.Uint24) int { return cmp.Compare(a.Uint64(), b.Uint64()) })
+}
+
+// OpenInt40 maps a file of 5-byte Int40 values in the given byte order.
+func OpenInt40(path string, order binary.ByteOrder) (*Array[int40.Int40], error) {
+	return open(path, order, int40.Int40SliceFromBytes, func(a, b int40.Int40) int { return cmp.Compare(a.Int64(), b.Int64()) })
+}
+
+// OpenUint40 maps a file of 5-byte Uint40 values in the given byte order.
+func OpenUint40(path string, order binary.ByteOrder) (*Array[int40.Uint40], error) {
+	return open(path, order, int40.Uint40SliceFromBytes, func(a, b int40.Uint40) int { return cmp.Compare(a.Uint64(), b.Uint64()) })
+}
+
+// OpenInt48 maps a file of 6-byte Int48 values in the given byte order.
+func OpenInt48(path string, order binary.ByteOrder) (*Array[int48.Int48], error) {
+	return open(path, order, int48.Int48SliceFromBytes, func(a, b int48.Int48) int { return cmp.Compare(a.Int64(), b.Int64()) })
+}
+
+// OpenUint48 maps a file of 6-byte Uint48 values in the given byte order.
+func OpenUint48(path string, order binary.ByteOrder) (*Array[int48.Uint48], error) {
+	return open(path, order, int48.Uint48SliceFromBytes, func(a, b int48.Uint48) int { return cmp.Compare(a.Uint64(), b.Uint64()) })
+}
+
+// OpenInt56 maps a file of 7-byte Int56 values in the given byte order.
+func OpenInt56(path string, order binary.ByteOrder) (*Array[int56.Int56], error) {
+	return open(path, order, int56.Int56SliceFromBytes, func(a, b int56.Int56) int { return cmp.Compare(a.Int64(), b.Int64()) })
+}
+
+// OpenUint56 maps a file of 7-byte Uint56 values in the given byte order.
+func OpenUint56(path string, order binary.ByteOrder) (*Array[int56.Uint56], error) {
+	return open(path, order, int56.Uint56SliceFromBytes, func(a, b int56.Uint56) int { return cmp.Compare(a.Uint64(), b.Uint64()) })
+}
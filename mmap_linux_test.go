@@ -0,0 +1,87 @@
+package intx
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	"github.com/CVDpl/go-intx/mmap"
+
+	"testing"
+)
+
+func TestMmapUint40(t *testing.T) {
+	values := []Uint40{MustUint40(1), MustUint40(5), MustUint40(5), MustUint40(0xFFFFFFFFFF)}
+	path := filepath.Join(t.TempDir(), "offsets.bin")
+	if err := os.WriteFile(path, AppendUint40sLE(nil, values), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := mmap.OpenUint40(path, binary.LittleEndian)
+	if err != nil {
+		t.Fatalf("OpenUint40() error = %v", err)
+	}
+	if a.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", a.Len())
+	}
+	for i, v := range a.All() {
+		if v != values[i] {
+			t.Errorf("All()[%d] = %v, want %v", i, v, values[i])
+		}
+	}
+	if v, err := a.At(3); err != nil || v.Uint64() != 0xFFFFFFFFFF {
+		t.Errorf("At(3) = %v, %v", v, err)
+	}
+	if _, err := a.At(4); err != mmap.ErrIndexOutOfRange {
+		t.Errorf("At(4) error = %v, want %v", err, mmap.ErrIndexOutOfRange)
+	}
+
+	searches := []struct {
+		v     uint64
+		index int
+		found bool
+	}{
+		{0, 0, false},
+		{5, 1, true},
+		{6, 3, false},
+		{0xFFFFFFFFFF, 3, true},
+	}
+	for _, s := range searches {
+		i, found := a.Search(MustUint40(s.v))
+		if i != s.index || found != s.found {
+			t.Errorf("Search(%d) = %d, %v, want %d, %v", s.v, i, found, s.index, s.found)
+		}
+	}
+
+	if err := a.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, err := a.At(0); err != mmap.ErrClosed {
+		t.Errorf("At() after Close error = %v, want %v", err, mmap.ErrClosed)
+	}
+}
+
+func TestMmapErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bad.bin")
+	if err := os.WriteFile(path, make([]byte, 7), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mmap.OpenInt48(path, binary.BigEndian); err != ErrInt48InvalidByteLength {
+		t.Errorf("OpenInt48() error = %v, want %v", err, ErrInt48InvalidByteLength)
+	}
+
+	empty := filepath.Join(dir, "empty.bin")
+	if err := os.WriteFile(empty, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	a, err := mmap.OpenUint48(empty, binary.BigEndian)
+	if err != nil || a.Len() != 0 {
+		t.Fatalf("OpenUint48(empty) = %v, %v", a, err)
+	}
+	if err := a.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+}
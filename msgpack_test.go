@@ -0,0 +1,108 @@
+package intx
+
+import (
+	"bytes"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+type msgpackValue interface {
+	MarshalMsgpack() ([]byte, error)
+}
+
+type msgpackTarget interface {
+	UnmarshalMsgpack([]byte) error
+}
+
+func TestMsgpackMarshalSmallest(t *testing.T) {
+	tests := []struct {
+		v    msgpackValue
+		want []byte
+	}{
+		{MustInt24(0), []byte{0x00}},
+		{MustInt24(127), []byte{0x7f}},
+		{MustInt24(128), []byte{0xcc, 0x80}},
+		{MustInt24(-1), []byte{0xff}},
+		{MustInt24(-32), []byte{0xe0}},
+		{MustInt24(-33), []byte{0xd0, 0xdf}},
+		{MustInt24(-129), []byte{0xd1, 0xff, 0x7f}},
+		{MustInt24(-8388608), []byte{0xd2, 0xff, 0x80, 0x00, 0x00}},
+		{MustUint24(0xFFFFFF), []byte{0xce, 0x00, 0xff, 0xff, 0xff}},
+		{MustUint40(0x10000), []byte{0xce, 0x00, 0x01, 0x00, 0x00}},
+		{MustInt40(-1 << 39), []byte{0xd3, 0xff, 0xff, 0xff, 0x80, 0, 0, 0, 0}},
+		{MustUint48(1 << 32), []byte{0xcf, 0, 0, 0, 1, 0, 0, 0, 0}},
+		{MustInt48(300), []byte{0xcd, 0x01, 0x2c}},
+		{MustInt56(-1 << 55), []byte{0xd3, 0xff, 0x80, 0, 0, 0, 0, 0, 0}},
+		{MustUint56(255), []byte{0xcc, 0xff}},
+	}
+	for _, tt := range tests {
+		got, err := tt.v.MarshalMsgpack()
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("%T(%v).MarshalMsgpack() = % x, %v, want % x", tt.v, tt.v, got, err, tt.want)
+		}
+	}
+}
+
+func TestMsgpackUnmarshal(t *testing.T) {
+	// Any integer format is accepted.
+	var u Uint40
+	if err := u.UnmarshalMsgpack([]byte{0xd3, 0, 0, 0, 0, 0, 0, 0, 0x2a}); err != nil || u.Uint64() != 42 {
+		t.Errorf("Uint40.UnmarshalMsgpack(int 64) = %v, %v", u, err)
+	}
+	var i Int56
+	if err := i.UnmarshalMsgpack([]byte{0xd1, 0xff, 0xfe}); err != nil || i.Int64() != -2 {
+		t.Errorf("Int56.UnmarshalMsgpack(int 16) = %v, %v", i, err)
+	}
+	var i24 Int24
+	if err := i24.UnmarshalMsgpack([]byte{0xcd, 0x7f, 0xff}); err != nil || i24.Int64() != 0x7fff {
+		t.Errorf("Int24.UnmarshalMsgpack(uint 16) = %v, %v", i24, err)
+	}
+
+	tests := []struct {
+		target msgpackTarget
+		data   []byte
+		want   error
+	}{
+		{new(Uint40), []byte{0xcf, 0, 0, 0x01, 0, 0, 0, 0, 0}, ErrUint40OutOfRange},
+		{new(Uint40), []byte{0xff}, ErrUint40OutOfRange},
+		{new(Uint24), []byte{0xd0, 0x80}, ErrUint24OutOfRange},
+		{new(Int24), []byte{0xce, 0x00, 0x80, 0x00, 0x00}, ErrInt24OutOfRange},
+		{new(Int40), []byte{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, ErrInt40OutOfRange},
+		{new(Int48), []byte{0xd3, 0xff, 0xff, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff}, ErrInt48OutOfRange},
+		{new(Uint48), []byte{0xcf, 0, 0x01, 0, 0, 0, 0, 0, 0}, ErrUint48OutOfRange},
+		{new(Int56), []byte{0xd3, 0x7f, 0, 0, 0, 0, 0, 0, 0}, ErrInt56OutOfRange},
+		{new(Uint56), []byte{0xcf, 0x01, 0, 0, 0, 0, 0, 0, 0}, ErrUint56OutOfRange},
+		{new(Int24), []byte{0xcd, 0x01}, ErrInt24InvalidMsgpack},
+		{new(Int24), []byte{0x01, 0x02}, ErrInt24InvalidMsgpack},
+		{new(Uint48), []byte{0xc0}, ErrInt48InvalidMsgpack},
+		{new(Int56), []byte{0xca, 0, 0, 0, 0}, ErrInt56InvalidMsgpack},
+		{new(Uint56), nil, ErrInt56EmptyData},
+	}
+	for _, tt := range tests {
+		if err := tt.target.UnmarshalMsgpack(tt.data); err != tt.want {
+			t.Errorf("%T.UnmarshalMsgpack(% x) error = %v, want %v", tt.target, tt.data, err, tt.want)
+		}
+	}
+}
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	for _, v := range []int64{0, 1, -1, 127, 128, -32, -33, 255, 256, -128, -129, 65535, 65536, -32768, -32769, 0x7FFFFF, -0x800000} {
+		data, _ := MustInt24(v).MarshalMsgpack()
+		var got Int24
+		if err := got.UnmarshalMsgpack(data); err != nil || got.Int64() != v {
+			t.Errorf("Int24 round trip of %d = %v, %v", v, got, err)
+		}
+	}
+	for _, v := range []uint64{0, 127, 128, 255, 256, 65535, 65536, 1<<32 - 1, 1 << 32, 1<<48 - 1} {
+		data, _ := MustUint48(v).MarshalMsgpack()
+		var got Uint48
+		if err := got.UnmarshalMsgpack(data); err != nil || got.Uint64() != v {
+			t.Errorf("Uint48 round trip of %d = %v, %v", v, got, err)
+		}
+	}
+}
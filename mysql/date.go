@@ -0,0 +1,194 @@
+package mysql
+
+import (
+	"cmp"
+	"time"
+
+	int24 "github.com/CVDpl/go-intx/24"
+)
+
+// Date24 is a MySQL DATE value in its 3-byte storage form,
+// YYYY*16*32 + MM*32 + DD. Binary log rows carry it little-endian.
+//
+// The zero value is the zero date 0000-00-00, which MySQL permits unless
+// NO_ZERO_DATE is set. Any other value must be a real calendar date in the
+// years 0 to 9999. Packed values sort in chronological order.
+type Date24 struct {
+	value int24.Uint24
+}
+
+// NewDate24 creates a Date24 from its components. It returns ErrInvalidDate
+// unless the date exists in the calendar or is the zero date 0000-00-00.
+func NewDate24(year int, month time.Month, day int) (Date24, error) {
+	if !validDate(year, month, day) {
+		return Date24{}, ErrInvalidDate
+	}
+	return Date24{value: int24.MustUint24(uint64(year*16*32 + int(month)*32 + day))}, nil
+}
+
+// Date24FromTime returns the date of t in t's location.
+func Date24FromTime(t time.Time) (Date24, error) {
+	return NewDate24(t.Date())
+}
+
+// Date24FromUint24 interprets a packed Uint24 as a Date24, validating it.
+func Date24FromUint24(u int24.Uint24) (Date24, error) {
+	v := int(u.Uint64())
+	return NewDate24(v>>9, time.Month(v>>5&0xF), v&0x1F)
+}
+
+// FromDate24Bytes creates a Date24 from a 3-byte big-endian slice.
+func FromDate24Bytes(b []byte) (Date24, error) {
+	u, err := int24.FromUint24Bytes(b)
+	if err != nil {
+		return Date24{}, err
+	}
+	return Date24FromUint24(u)
+}
+
+// FromDate24LittleEndianBytes creates a Date24 from a 3-byte little-endian
+// slice, the form used in binary log rows.
+func FromDate24LittleEndianBytes(b []byte) (Date24, error) {
+	u, err := int24.FromUint24LittleEndianBytes(b)
+	if err != nil {
+		return Date24{}, err
+	}
+	return Date24FromUint24(u)
+}
+
+// Uint24 returns the packed representation of d.
+func (d Date24) Uint24() int24.Uint24 { return d.value }
+
+// Date returns the year, month and day of d.
+func (d Date24) Date() (year int, month time.Month, day int) {
+	v := int(d.value.Uint64())
+	return v >> 9, time.Month(v >> 5 & 0xF), v & 0x1F
+}
+
+// IsZero reports whether d is the zero date 0000-00-00.
+func (d Date24) IsZero() bool { return d.value.Uint64() == 0 }
+
+// Time returns midnight UTC on d. It returns ErrZeroDate for the zero date.
+func (d Date24) Time() (time.Time, error) {
+	if d.IsZero() {
+		return time.Time{}, ErrZeroDate
+	}
+	year, month, day := d.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+}
+
+// Compare returns -1, 0 or +1 as d is before, equal to or after other.
+func (d Date24) Compare(other Date24) int {
+	return cmp.Compare(d.value.Uint64(), other.value.Uint64())
+}
+
+// Before reports whether d is before other.
+func (d Date24) Before(other Date24) bool { return d.Compare(other) < 0 }
+
+// After reports whether d is after other.
+func (d Date24) After(other Date24) bool { return d.Compare(other) > 0 }
+
+// ToBytes returns a 3-byte big-endian representation of d.
+func (d Date24) ToBytes() [3]byte { return d.value.ToBytes() }
+
+// ToLittleEndianBytes returns a 3-byte little-endian representation of d.
+func (d Date24) ToLittleEndianBytes() [3]byte { return d.value.ToLittleEndianBytes() }
+
+// String returns d formatted as YYYY-MM-DD.
+func (d Date24) String() string { return string(d.appendString(nil)) }
+
+func (d Date24) appendString(b []byte) []byte {
+	year, month, day := d.Date()
+	b = appendDigits(b, year, 4)
+	b = appendDigits(append(b, '-'), int(month), 2)
+	return appendDigits(append(b, '-'), day, 2)
+}
+
+// ParseDate24 parses a date formatted as YYYY-MM-DD.
+func ParseDate24(s string) (Date24, error) {
+	if len(s) != 10 || s[4] != '-' || s[7] != '-' {
+		return Date24{}, ErrInvalidDate
+	}
+	year, ok1 := parseDigits(s[0:4])
+	month, ok2 := parseDigits(s[5:7])
+	day, ok3 := parseDigits(s[8:10])
+	if !ok1 || !ok2 || !ok3 {
+		return Date24{}, ErrInvalidDate
+	}
+	return NewDate24(year, time.Month(month), day)
+}
+
+// MarshalJSON implements json.Marshaler for Date24.
+func (d Date24) MarshalJSON() ([]byte, error) {
+	b := d.appendString(append(make([]byte, 0, 12), '"'))
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Date24.
+func (d *Date24) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return ErrInvalidDate
+	}
+	newD, err := ParseDate24(string(data[1 : len(data)-1]))
+	if err != nil {
+		return err
+	}
+	*d = newD
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Date24.
+func (d Date24) MarshalBinary() ([]byte, error) {
+	bytes := d.ToBytes()
+	return bytes[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Date24.
+func (d *Date24) UnmarshalBinary(data []byte) error {
+	newD, err := FromDate24Bytes(data)
+	if err != nil {
+		return err
+	}
+	*d = newD
+	return nil
+}
+
+// validDate reports whether the date exists or is the zero date.
+func validDate(year int, month time.Month, day int) bool {
+	if year == 0 && month == 0 && day == 0 {
+		return true
+	}
+	if year < 0 || year > 9999 || month < time.January || month > time.December || day < 1 {
+		return false
+	}
+	// time.Date normalizes day 0 of the next month to the last day of this one.
+	return day <= time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// appendDigits appends v in decimal, zero-padded to width digits.
+func appendDigits(b []byte, v, width int) []byte {
+	var buf [8]byte
+	i := len(buf)
+	for ; width > 0 || v > 0; width-- {
+		i--
+		buf[i] = byte('0' + v%10)
+		v /= 10
+	}
+	return append(b, buf[i:]...)
+}
+
+// parseDigits parses a non-empty string of ASCII digits.
+func parseDigits(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	v := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		v = v*10 + int(c-'0')
+	}
+	return v, true
+}
@@ -0,0 +1,275 @@
+package mysql
+
+import (
+	"cmp"
+	"time"
+
+	int40 "github.com/CVDpl/go-intx/40"
+)
+
+// datetimeOffset is added to the packed integer part so that the stored
+// bytes of non-negative values have the sign bit set.
+const datetimeOffset = 0x8000000000
+
+// DateTime40 is a MySQL DATETIME(fsp) value in its DATETIME2 storage form: a
+// 5-byte big-endian integer part followed by 0 to 3 bytes of fractional
+// seconds, depending on the column's fractional seconds precision (fsp).
+//
+// The integer part holds, from the most significant bit:
+//
+//	 1 bit  sign (always 1)
+//	17 bits year*13 + month
+//	 5 bits day
+//	 5 bits hour
+//	 6 bits minute
+//	 6 bits second
+//
+// As with Date24, the zero value is 0000-00-00 00:00:00. Values sort in
+// chronological order.
+type DateTime40 struct {
+	value int40.Uint40 // integer part without the sign offset
+	micro uint32       // microseconds
+}
+
+// NewDateTime40 creates a DateTime40 from its components. It returns
+// ErrInvalidDate if any component is out of range or the date does not
+// exist; only an all-zero date may be combined with a zero month and day.
+func NewDateTime40(year int, month time.Month, day, hour, min, sec, micro int) (DateTime40, error) {
+	if !validDate(year, month, day) || hour < 0 || hour > 23 || min < 0 || min > 59 ||
+		sec < 0 || sec > 59 || micro < 0 || micro > 999999 {
+		return DateTime40{}, ErrInvalidDate
+	}
+	ymd := uint64(year*13+int(month))<<5 | uint64(day)
+	hms := uint64(hour)<<12 | uint64(min)<<6 | uint64(sec)
+	return DateTime40{value: int40.MustUint40(ymd<<17 | hms), micro: uint32(micro)}, nil
+}
+
+// DateTime40FromTime returns the wall clock time of t in t's location,
+// truncated to microseconds.
+func DateTime40FromTime(t time.Time) (DateTime40, error) {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return NewDateTime40(year, month, day, hour, min, sec, t.Nanosecond()/1000)
+}
+
+// DateTimeFracSize returns the number of fractional-second bytes stored for
+// precision fsp, or -1 if fsp is not between 0 and 6.
+func DateTimeFracSize(fsp int) int {
+	if fsp < 0 || fsp > 6 {
+		return -1
+	}
+	return (fsp + 1) / 2
+}
+
+// FromDateTime40Bytes decodes a DATETIME2 value of precision fsp. The slice
+// must be exactly 5+DateTimeFracSize(fsp) bytes long.
+func FromDateTime40Bytes(b []byte, fsp int) (DateTime40, error) {
+	size := DateTimeFracSize(fsp)
+	if size < 0 {
+		return DateTime40{}, ErrInvalidFSP
+	}
+	if len(b) != 5+size {
+		return DateTime40{}, int40.ErrInt40InvalidByteLength
+	}
+	u, err := int40.FromUint40Bytes(b[:5])
+	if err != nil {
+		return DateTime40{}, err
+	}
+	if u.Uint64() < datetimeOffset {
+		return DateTime40{}, ErrInvalidDate // negative datetimes do not occur
+	}
+
+	var frac int
+	for _, c := range b[5:] {
+		frac = frac<<8 | int(c)
+	}
+	switch size {
+	case 1:
+		frac *= 10000
+	case 2:
+		frac *= 100
+	}
+
+	v := u.Uint64() - datetimeOffset
+	ym := int(v >> 22)
+	return NewDateTime40(ym/13, time.Month(ym%13), int(v>>17&0x1F),
+		int(v>>12&0x1F), int(v>>6&0x3F), int(v&0x3F), frac)
+}
+
+// Date returns the year, month and day of d.
+func (d DateTime40) Date() (year int, month time.Month, day int) {
+	v := d.value.Uint64()
+	ym := int(v >> 22)
+	return ym / 13, time.Month(ym % 13), int(v >> 17 & 0x1F)
+}
+
+// Clock returns the hour, minute and second of d.
+func (d DateTime40) Clock() (hour, min, sec int) {
+	v := d.value.Uint64()
+	return int(v >> 12 & 0x1F), int(v >> 6 & 0x3F), int(v & 0x3F)
+}
+
+// Microsecond returns the fractional second of d in microseconds.
+func (d DateTime40) Microsecond() int { return int(d.micro) }
+
+// Truncate returns d with its fractional second truncated to fsp digits.
+// It returns d unchanged if fsp is not between 0 and 6.
+func (d DateTime40) Truncate(fsp int) DateTime40 {
+	if fsp >= 0 && fsp < 6 {
+		unit := uint32(1)
+		for range 6 - fsp {
+			unit *= 10
+		}
+		d.micro -= d.micro % unit
+	}
+	return d
+}
+
+// IsZero reports whether d is 0000-00-00 00:00:00.
+func (d DateTime40) IsZero() bool { return d.value.Uint64() == 0 && d.micro == 0 }
+
+// Time returns d as a UTC time. It returns ErrZeroDate if the date part is
+// 0000-00-00.
+func (d DateTime40) Time() (time.Time, error) {
+	year, month, day := d.Date()
+	if month == 0 {
+		return time.Time{}, ErrZeroDate
+	}
+	hour, min, sec := d.Clock()
+	return time.Date(year, month, day, hour, min, sec, int(d.micro)*1000, time.UTC), nil
+}
+
+// Compare returns -1, 0 or +1 as d is before, equal to or after other.
+func (d DateTime40) Compare(other DateTime40) int {
+	if c := cmp.Compare(d.value.Uint64(), other.value.Uint64()); c != 0 {
+		return c
+	}
+	return cmp.Compare(d.micro, other.micro)
+}
+
+// Before reports whether d is before other.
+func (d DateTime40) Before(other DateTime40) bool { return d.Compare(other) < 0 }
+
+// After reports whether d is after other.
+func (d DateTime40) After(other DateTime40) bool { return d.Compare(other) > 0 }
+
+// ToBytes returns the 5-byte big-endian integer part of d, as stored in a
+// DATETIME(0) column.
+func (d DateTime40) ToBytes() [5]byte {
+	return int40.MustUint40(d.value.Uint64() + datetimeOffset).ToBytes()
+}
+
+// AppendBytes appends the DATETIME2 encoding of d with precision fsp to b.
+// The fractional second is truncated to fsp digits.
+func (d DateTime40) AppendBytes(b []byte, fsp int) ([]byte, error) {
+	size := DateTimeFracSize(fsp)
+	if size < 0 {
+		return b, ErrInvalidFSP
+	}
+	ip := d.ToBytes()
+	b = append(b, ip[:]...)
+	frac := d.micro
+	switch size {
+	case 1:
+		frac /= 10000
+	case 2:
+		frac /= 100
+	}
+	for i := size - 1; i >= 0; i-- {
+		b = append(b, byte(frac>>(8*i)))
+	}
+	return b, nil
+}
+
+// String returns d formatted as YYYY-MM-DD hh:mm:ss, followed by six
+// fractional digits if the fractional second is non-zero.
+func (d DateTime40) String() string { return string(d.appendString(nil)) }
+
+func (d DateTime40) appendString(b []byte) []byte {
+	year, month, day := d.Date()
+	hour, min, sec := d.Clock()
+	b = appendDigits(b, year, 4)
+	b = appendDigits(append(b, '-'), int(month), 2)
+	b = appendDigits(append(b, '-'), day, 2)
+	b = appendDigits(append(b, ' '), hour, 2)
+	b = appendDigits(append(b, ':'), min, 2)
+	b = appendDigits(append(b, ':'), sec, 2)
+	if d.micro != 0 {
+		b = appendDigits(append(b, '.'), int(d.micro), 6)
+	}
+	return b
+}
+
+// ParseDateTime40 parses a datetime formatted as YYYY-MM-DD hh:mm:ss with an
+// optional fraction of 1 to 6 digits. A 'T' may separate date and time.
+func ParseDateTime40(s string) (DateTime40, error) {
+	if len(s) < 19 || (s[10] != ' ' && s[10] != 'T') || s[13] != ':' || s[16] != ':' {
+		return DateTime40{}, ErrInvalidDate
+	}
+	date, err := ParseDate24(s[:10])
+	if err != nil {
+		return DateTime40{}, err
+	}
+	hour, ok1 := parseDigits(s[11:13])
+	min, ok2 := parseDigits(s[14:16])
+	sec, ok3 := parseDigits(s[17:19])
+	if !ok1 || !ok2 || !ok3 {
+		return DateTime40{}, ErrInvalidDate
+	}
+	micro := 0
+	if frac := s[19:]; frac != "" {
+		if frac[0] != '.' || len(frac) > 7 {
+			return DateTime40{}, ErrInvalidDate
+		}
+		v, ok := parseDigits(frac[1:])
+		if !ok {
+			return DateTime40{}, ErrInvalidDate
+		}
+		for range 7 - len(frac) {
+			v *= 10
+		}
+		micro = v
+	}
+	year, month, day := date.Date()
+	return NewDateTime40(year, month, day, hour, min, sec, micro)
+}
+
+// MarshalJSON implements json.Marshaler for DateTime40.
+func (d DateTime40) MarshalJSON() ([]byte, error) {
+	b := d.appendString(append(make([]byte, 0, 28), '"'))
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for DateTime40.
+func (d *DateTime40) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return ErrInvalidDate
+	}
+	newD, err := ParseDateTime40(string(data[1 : len(data)-1]))
+	if err != nil {
+		return err
+	}
+	*d = newD
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for DateTime40. It
+// produces the 8-byte DATETIME(6) encoding.
+func (d DateTime40) MarshalBinary() ([]byte, error) {
+	return d.AppendBytes(make([]byte, 0, 8), 6)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for DateTime40. The
+// precision is inferred from the length: 5 bytes for DATETIME(0), 6 for
+// DATETIME(2), 7 for DATETIME(4) and 8 for DATETIME(6).
+func (d *DateTime40) UnmarshalBinary(data []byte) error {
+	if len(data) < 5 || len(data) > 8 {
+		return int40.ErrInt40InvalidByteLength
+	}
+	newD, err := FromDateTime40Bytes(data, 2*(len(data)-5))
+	if err != nil {
+		return err
+	}
+	*d = newD
+	return nil
+}
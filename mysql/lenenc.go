@@ -0,0 +1,86 @@
+package mysql
+
+import (
+	"encoding/binary"
+
+	int24 "github.com/CVDpl/go-intx/24"
+)
+
+// Length-encoded integer prefixes.
+const (
+	lenencNull   = 0xFB // NULL in a text resultset row
+	lenencUint16 = 0xFC
+	lenencUint24 = 0xFD
+	lenencUint64 = 0xFE
+)
+
+// LengthEncodedIntSize returns the number of bytes AppendLengthEncodedInt
+// uses to encode v.
+func LengthEncodedIntSize(v uint64) int {
+	switch {
+	case v < lenencNull:
+		return 1
+	case v <= 0xFFFF:
+		return 3
+	case v <= 0xFFFFFF:
+		return 4
+	default:
+		return 9
+	}
+}
+
+// AppendLengthEncodedInt appends v as a length-encoded integer (int<lenenc>)
+// using the shortest form: a single byte below 0xFB, otherwise a 0xFC, 0xFD
+// or 0xFE prefix followed by a 2-, 3- or 8-byte little-endian value.
+func AppendLengthEncodedInt(b []byte, v uint64) []byte {
+	switch {
+	case v < lenencNull:
+		return append(b, byte(v))
+	case v <= 0xFFFF:
+		return binary.LittleEndian.AppendUint16(append(b, lenencUint16), uint16(v))
+	case v <= 0xFFFFFF:
+		return AppendUint24(append(b, lenencUint24), int24.MustUint24(v))
+	default:
+		return binary.LittleEndian.AppendUint64(append(b, lenencUint64), v)
+	}
+}
+
+// AppendLengthEncodedNull appends the 0xFB marker that stands for NULL in
+// text resultset rows.
+func AppendLengthEncodedNull(b []byte) []byte {
+	return append(b, lenencNull)
+}
+
+// ReadLengthEncodedInt decodes a length-encoded integer from the start of b.
+// It returns the value, whether the encoding was the NULL marker, and the
+// number of bytes consumed. A leading 0xFF, which introduces an error
+// packet, is rejected with ErrInvalidLengthEncoding.
+func ReadLengthEncodedInt(b []byte) (v uint64, null bool, n int, err error) {
+	if len(b) == 0 {
+		return 0, false, 0, ErrShortBuffer
+	}
+	switch b[0] {
+	case lenencNull:
+		return 0, true, 1, nil
+	case lenencUint16:
+		if len(b) < 3 {
+			return 0, false, 0, ErrShortBuffer
+		}
+		return uint64(binary.LittleEndian.Uint16(b[1:])), false, 3, nil
+	case lenencUint24:
+		u, err := ReadUint24(b[1:])
+		if err != nil {
+			return 0, false, 0, err
+		}
+		return u.Uint64(), false, 4, nil
+	case lenencUint64:
+		if len(b) < 9 {
+			return 0, false, 0, ErrShortBuffer
+		}
+		return binary.LittleEndian.Uint64(b[1:]), false, 9, nil
+	case 0xFF:
+		return 0, false, 0, ErrInvalidLengthEncoding
+	default:
+		return uint64(b[0]), false, 1, nil
+	}
+}
@@ -0,0 +1,93 @@
+// Package mysql implements the low-level framing and integer encodings of
+// the MySQL client/server protocol and binary log, built on the intx
+// Uint24 and Uint48 types.
+//
+// Every protocol packet starts with a 4-byte header: a 3-byte little-endian
+// payload length followed by a sequence number. Payloads of MaxPayloadLen
+// bytes or more are split across several packets; a packet shorter than
+// MaxPayloadLen (possibly empty) ends the logical payload. Reader and Writer
+// handle this splitting and reassembly.
+//
+// Date24 and DateTime40 decode the packed DATE and DATETIME2 column formats
+// found in InnoDB records and binary log rows.
+package mysql
+
+import (
+	"errors"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int48 "github.com/CVDpl/go-intx/48"
+)
+
+// Common errors for the mysql package
+var (
+	ErrShortBuffer           = errors.New("mysql: buffer too short")
+	ErrPacketSequence        = errors.New("mysql: packet out of sequence")
+	ErrPacketTooLarge        = errors.New("mysql: packet exceeds maximum size")
+	ErrInvalidLengthEncoding = errors.New("mysql: invalid length-encoded integer")
+	ErrInvalidDate           = errors.New("mysql: invalid date or time")
+	ErrZeroDate              = errors.New("mysql: zero date has no time.Time equivalent")
+	ErrInvalidFSP            = errors.New("mysql: fractional seconds precision must be 0 to 6")
+)
+
+const (
+	// HeaderSize is the size of a packet header in bytes.
+	HeaderSize = 4
+
+	// MaxPayloadLen is the largest payload a single packet can carry
+	// (16 MiB - 1). A packet of exactly this length is continued by the next.
+	MaxPayloadLen = 1<<24 - 1
+)
+
+// Header is a packet header.
+type Header struct {
+	Length int24.Uint24 // payload length of this packet
+	Seq    uint8        // sequence number
+}
+
+// AppendHeader appends the 4-byte encoding of h to b.
+func AppendHeader(b []byte, h Header) []byte {
+	le := h.Length.ToLittleEndianBytes()
+	return append(b, le[0], le[1], le[2], h.Seq)
+}
+
+// ParseHeader decodes a packet header from the first HeaderSize bytes of b.
+func ParseHeader(b []byte) (Header, error) {
+	if len(b) < HeaderSize {
+		return Header{}, ErrShortBuffer
+	}
+	length, err := int24.FromUint24LittleEndianBytes(b[:3])
+	if err != nil {
+		return Header{}, err
+	}
+	return Header{Length: length, Seq: b[3]}, nil
+}
+
+// AppendUint24 appends v as a 3-byte little-endian integer (int<3>).
+func AppendUint24(b []byte, v int24.Uint24) []byte {
+	le := v.ToLittleEndianBytes()
+	return append(b, le[:]...)
+}
+
+// ReadUint24 decodes a 3-byte little-endian integer from the start of b.
+func ReadUint24(b []byte) (int24.Uint24, error) {
+	if len(b) < 3 {
+		return int24.Uint24{}, ErrShortBuffer
+	}
+	return int24.FromUint24LittleEndianBytes(b[:3])
+}
+
+// AppendUint48 appends v as a 6-byte little-endian integer (int<6>), as used
+// for table IDs in binary log events.
+func AppendUint48(b []byte, v int48.Uint48) []byte {
+	le := v.ToLittleEndianBytes()
+	return append(b, le[:]...)
+}
+
+// ReadUint48 decodes a 6-byte little-endian integer from the start of b.
+func ReadUint48(b []byte) (int48.Uint48, error) {
+	if len(b) < 6 {
+		return int48.Uint48{}, ErrShortBuffer
+	}
+	return int48.FromUint48LittleEndianBytes(b[:6])
+}
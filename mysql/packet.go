@@ -0,0 +1,110 @@
+package mysql
+
+import (
+	"io"
+	"net"
+
+	int24 "github.com/CVDpl/go-intx/24"
+)
+
+// Reader reads logical payloads from a stream of MySQL packets, joining
+// payloads that were split across several packets.
+type Reader struct {
+	r   io.Reader
+	seq uint8
+	hdr [HeaderSize]byte
+
+	// MaxPayloadSize, if positive, limits the size of a reassembled payload.
+	// Longer payloads fail with ErrPacketTooLarge.
+	MaxPayloadSize int
+}
+
+// NewReader returns a Reader that expects the next packet to carry sequence
+// number 0.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Seq returns the sequence number expected on the next packet.
+func (r *Reader) Seq() uint8 { return r.seq }
+
+// SetSeq sets the sequence number expected on the next packet. Clients reset
+// it to 0 at the start of each command.
+func (r *Reader) SetSeq(seq uint8) { r.seq = seq }
+
+// ReadPacket reads the next logical payload. Each packet's sequence number
+// must match the expected one, otherwise ErrPacketSequence is returned. A
+// stream that ends inside a packet yields io.ErrUnexpectedEOF.
+func (r *Reader) ReadPacket() ([]byte, error) {
+	var payload []byte
+	for first := true; ; first = false {
+		if _, err := io.ReadFull(r.r, r.hdr[:]); err != nil {
+			if err == io.EOF && !first {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		h, err := ParseHeader(r.hdr[:])
+		if err != nil {
+			return nil, err
+		}
+		if h.Seq != r.seq {
+			return nil, ErrPacketSequence
+		}
+		r.seq++
+
+		n := int(h.Length.Uint64())
+		if r.MaxPayloadSize > 0 && len(payload)+n > r.MaxPayloadSize {
+			return nil, ErrPacketTooLarge
+		}
+		start := len(payload)
+		payload = append(payload, make([]byte, n)...)
+		if _, err := io.ReadFull(r.r, payload[start:]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if n < MaxPayloadLen {
+			return payload, nil
+		}
+	}
+}
+
+// Writer writes logical payloads as a stream of MySQL packets, splitting
+// payloads of MaxPayloadLen bytes or more.
+type Writer struct {
+	w   io.Writer
+	seq uint8
+	hdr [HeaderSize]byte
+}
+
+// NewWriter returns a Writer whose first packet carries sequence number 0.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Seq returns the sequence number of the next packet to be written.
+func (w *Writer) Seq() uint8 { return w.seq }
+
+// SetSeq sets the sequence number of the next packet to be written.
+func (w *Writer) SetSeq(seq uint8) { w.seq = seq }
+
+// WritePacket writes payload as one or more packets. A payload whose length
+// is a multiple of MaxPayloadLen is terminated by an empty packet.
+func (w *Writer) WritePacket(payload []byte) error {
+	for {
+		n := min(len(payload), MaxPayloadLen)
+		AppendHeader(w.hdr[:0], Header{Length: int24.MustUint24(uint64(n)), Seq: w.seq})
+		// net.Buffers uses a single writev on connections.
+		bufs := net.Buffers{w.hdr[:], payload[:n]}
+		if _, err := bufs.WriteTo(w.w); err != nil {
+			return err
+		}
+		w.seq++
+		payload = payload[n:]
+		if n < MaxPayloadLen {
+			return nil
+		}
+	}
+}
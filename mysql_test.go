@@ -0,0 +1,147 @@
+package intx
+
+import (
+	"bytes"
+	"io"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/48"
+	"github.com/CVDpl/go-intx/mysql"
+
+	"testing"
+)
+
+func TestMySQLHeader(t *testing.T) {
+	b := mysql.AppendHeader(nil, mysql.Header{Length: MustUint24(0x010203), Seq: 7})
+	if want := []byte{0x03, 0x02, 0x01, 0x07}; !bytes.Equal(b, want) {
+		t.Errorf("AppendHeader() = % x, want % x", b, want)
+	}
+	h, err := mysql.ParseHeader(b)
+	if err != nil || h.Length.Uint64() != 0x010203 || h.Seq != 7 {
+		t.Errorf("ParseHeader() = %+v, %v", h, err)
+	}
+	if _, err := mysql.ParseHeader(b[:3]); err != mysql.ErrShortBuffer {
+		t.Errorf("ParseHeader() error = %v, want %v", err, mysql.ErrShortBuffer)
+	}
+}
+
+func TestMySQLPacketRoundTrip(t *testing.T) {
+	sizes := []int{0, 1, 250, mysql.MaxPayloadLen - 1, mysql.MaxPayloadLen, mysql.MaxPayloadLen + 10}
+	for _, size := range sizes {
+		payload := make([]byte, size)
+		for i := range payload {
+			payload[i] = byte(i * 7)
+		}
+
+		var buf bytes.Buffer
+		w := mysql.NewWriter(&buf)
+		w.SetSeq(3)
+		if err := w.WritePacket(payload); err != nil {
+			t.Fatalf("WritePacket(%d) error = %v", size, err)
+		}
+		packets := size/mysql.MaxPayloadLen + 1
+		if got := buf.Len(); got != size+packets*mysql.HeaderSize {
+			t.Errorf("WritePacket(%d) wrote %d bytes, want %d", size, got, size+packets*mysql.HeaderSize)
+		}
+		if w.Seq() != uint8(3+packets) {
+			t.Errorf("Writer.Seq() = %d, want %d", w.Seq(), 3+packets)
+		}
+
+		r := mysql.NewReader(&buf)
+		r.SetSeq(3)
+		got, err := r.ReadPacket()
+		if err != nil {
+			t.Fatalf("ReadPacket(%d) error = %v", size, err)
+		}
+		if !bytes.Equal(got, payload) {
+			t.Errorf("ReadPacket(%d) payload mismatch", size)
+		}
+		if r.Seq() != w.Seq() {
+			t.Errorf("Reader.Seq() = %d, want %d", r.Seq(), w.Seq())
+		}
+		if _, err := r.ReadPacket(); err != io.EOF {
+			t.Errorf("ReadPacket() at end error = %v, want io.EOF", err)
+		}
+	}
+}
+
+func TestMySQLReaderErrors(t *testing.T) {
+	var buf bytes.Buffer
+	w := mysql.NewWriter(&buf)
+	w.WritePacket([]byte("hello"))
+	data := buf.Bytes()
+
+	r := mysql.NewReader(bytes.NewReader(data))
+	r.SetSeq(1)
+	if _, err := r.ReadPacket(); err != mysql.ErrPacketSequence {
+		t.Errorf("ReadPacket() error = %v, want %v", err, mysql.ErrPacketSequence)
+	}
+
+	r = mysql.NewReader(bytes.NewReader(data[:len(data)-1]))
+	if _, err := r.ReadPacket(); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadPacket() truncated error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+
+	r = mysql.NewReader(bytes.NewReader(data))
+	r.MaxPayloadSize = 4
+	if _, err := r.ReadPacket(); err != mysql.ErrPacketTooLarge {
+		t.Errorf("ReadPacket() error = %v, want %v", err, mysql.ErrPacketTooLarge)
+	}
+}
+
+func TestMySQLLengthEncodedInt(t *testing.T) {
+	tests := []struct {
+		v    uint64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{250, []byte{0xFA}},
+		{251, []byte{0xFC, 0xFB, 0x00}},
+		{0xFFFF, []byte{0xFC, 0xFF, 0xFF}},
+		{0x10000, []byte{0xFD, 0x00, 0x00, 0x01}},
+		{0xFFFFFF, []byte{0xFD, 0xFF, 0xFF, 0xFF}},
+		{0x1000000, []byte{0xFE, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}},
+		{^uint64(0), []byte{0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}},
+	}
+	for _, tt := range tests {
+		b := mysql.AppendLengthEncodedInt(nil, tt.v)
+		if !bytes.Equal(b, tt.want) {
+			t.Errorf("AppendLengthEncodedInt(%d) = % x, want % x", tt.v, b, tt.want)
+		}
+		if n := mysql.LengthEncodedIntSize(tt.v); n != len(tt.want) {
+			t.Errorf("LengthEncodedIntSize(%d) = %d, want %d", tt.v, n, len(tt.want))
+		}
+		v, null, n, err := mysql.ReadLengthEncodedInt(append(b, 0xAA))
+		if err != nil || null || v != tt.v || n != len(tt.want) {
+			t.Errorf("ReadLengthEncodedInt(% x) = %d, %v, %d, %v", b, v, null, n, err)
+		}
+		if _, _, _, err := mysql.ReadLengthEncodedInt(b[:len(b)-1]); err != mysql.ErrShortBuffer {
+			t.Errorf("ReadLengthEncodedInt(short) error = %v, want %v", err, mysql.ErrShortBuffer)
+		}
+	}
+
+	if _, null, n, err := mysql.ReadLengthEncodedInt(mysql.AppendLengthEncodedNull(nil)); !null || n != 1 || err != nil {
+		t.Errorf("ReadLengthEncodedInt(NULL) = %v, %d, %v", null, n, err)
+	}
+	if _, _, _, err := mysql.ReadLengthEncodedInt([]byte{0xFF}); err != mysql.ErrInvalidLengthEncoding {
+		t.Errorf("ReadLengthEncodedInt(0xFF) error = %v, want %v", err, mysql.ErrInvalidLengthEncoding)
+	}
+}
+
+func TestMySQLFixedInts(t *testing.T) {
+	b := mysql.AppendUint48(nil, MustUint48(0x0A0B0C0D0E0F))
+	if want := []byte{0x0F, 0x0E, 0x0D, 0x0C, 0x0B, 0x0A}; !bytes.Equal(b, want) {
+		t.Errorf("AppendUint48() = % x, want % x", b, want)
+	}
+	if v, err := mysql.ReadUint48(b); err != nil || v.Uint64() != 0x0A0B0C0D0E0F {
+		t.Errorf("ReadUint48() = %v, %v", v, err)
+	}
+	if _, err := mysql.ReadUint48(b[:5]); err != mysql.ErrShortBuffer {
+		t.Errorf("ReadUint48() error = %v, want %v", err, mysql.ErrShortBuffer)
+	}
+
+	b = mysql.AppendUint24(nil, MustUint24(0x123456))
+	if v, err := mysql.ReadUint24(b); err != nil || v.Uint64() != 0x123456 || len(b) != 3 {
+		t.Errorf("ReadUint24() = %v, %v", v, err)
+	}
+}
@@ -0,0 +1,183 @@
+package intx
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/CVDpl/go-intx/24"
+	"github.com/CVDpl/go-intx/mysql"
+
+	"testing"
+)
+
+func TestDate24(t *testing.T) {
+	d, err := mysql.NewDate24(2024, time.February, 29)
+	if err != nil {
+		t.Fatalf("NewDate24() error = %v", err)
+	}
+	if want := uint64(2024*16*32 + 2*32 + 29); d.Uint24().Uint64() != want {
+		t.Errorf("Uint24() = %d, want %d", d.Uint24().Uint64(), want)
+	}
+	if d.String() != "2024-02-29" {
+		t.Errorf("String() = %q", d.String())
+	}
+	tm, err := d.Time()
+	if want := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC); err != nil || !tm.Equal(want) {
+		t.Errorf("Time() = %v, %v, want %v", tm, err, want)
+	}
+
+	le := d.ToLittleEndianBytes()
+	if d2, err := mysql.FromDate24LittleEndianBytes(le[:]); err != nil || d2 != d {
+		t.Errorf("FromDate24LittleEndianBytes() = %v, %v", d2, err)
+	}
+
+	invalid := [][3]int{{2023, 2, 29}, {2024, 4, 31}, {2024, 13, 1}, {2024, 0, 1}, {10000, 1, 1}, {2024, 1, 0}}
+	for _, c := range invalid {
+		if _, err := mysql.NewDate24(c[0], time.Month(c[1]), c[2]); err != mysql.ErrInvalidDate {
+			t.Errorf("NewDate24(%v) error = %v, want %v", c, err, mysql.ErrInvalidDate)
+		}
+	}
+	if _, err := mysql.Date24FromUint24(MustUint24(2024*512 + 15*32 + 1)); err != mysql.ErrInvalidDate {
+		t.Errorf("Date24FromUint24() error = %v, want %v", err, mysql.ErrInvalidDate)
+	}
+
+	var zero mysql.Date24
+	if !zero.IsZero() || zero.String() != "0000-00-00" {
+		t.Errorf("zero Date24 = %q, IsZero() = %v", zero.String(), zero.IsZero())
+	}
+	if _, err := zero.Time(); err != mysql.ErrZeroDate {
+		t.Errorf("Time() error = %v, want %v", err, mysql.ErrZeroDate)
+	}
+
+	next, _ := mysql.NewDate24(2024, time.March, 1)
+	if !d.Before(next) || !next.After(d) || d.Compare(d) != 0 || !zero.Before(d) {
+		t.Error("Date24 ordering is not chronological")
+	}
+}
+
+func TestDate24JSONBinary(t *testing.T) {
+	d, _ := mysql.Date24FromTime(time.Date(1999, 12, 31, 23, 0, 0, 0, time.UTC))
+	data, err := json.Marshal(d)
+	if err != nil || string(data) != `"1999-12-31"` {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+	var d2 mysql.Date24
+	if err := json.Unmarshal(data, &d2); err != nil || d2 != d {
+		t.Errorf("json.Unmarshal() = %v, %v", d2, err)
+	}
+	if err := json.Unmarshal([]byte(`"1999-02-30"`), &d2); err != mysql.ErrInvalidDate {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, mysql.ErrInvalidDate)
+	}
+
+	bin, _ := d.MarshalBinary()
+	var d3 mysql.Date24
+	if err := d3.UnmarshalBinary(bin); err != nil || d3 != d {
+		t.Errorf("UnmarshalBinary() = %v, %v", d3, err)
+	}
+}
+
+func TestDateTime40(t *testing.T) {
+	tm := time.Date(2017, 10, 24, 13, 45, 7, 123456789, time.UTC)
+	d, err := mysql.DateTime40FromTime(tm)
+	if err != nil {
+		t.Fatalf("DateTime40FromTime() error = %v", err)
+	}
+	if d.String() != "2017-10-24 13:45:07.123456" {
+		t.Errorf("String() = %q", d.String())
+	}
+	got, err := d.Time()
+	if want := tm.Truncate(time.Microsecond); err != nil || !got.Equal(want) {
+		t.Errorf("Time() = %v, %v, want %v", got, err, want)
+	}
+
+	// Integer part from MySQL's documented layout.
+	ymd := uint64(2017*13+10)<<5 | 24
+	hms := uint64(13)<<12 | 45<<6 | 7
+	ip := 0x8000000000 | ymd<<17 | hms
+	want := []byte{byte(ip >> 32), byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}
+	if b := d.ToBytes(); !bytes.Equal(b[:], want) {
+		t.Errorf("ToBytes() = % x, want % x", b, want)
+	}
+
+	tests := []struct {
+		fsp  int
+		frac []byte
+		str  string
+	}{
+		{0, nil, "2017-10-24 13:45:07"},
+		{1, []byte{12}, "2017-10-24 13:45:07.120000"},
+		{2, []byte{12}, "2017-10-24 13:45:07.120000"},
+		{3, []byte{0x04, 0xD2}, "2017-10-24 13:45:07.123400"},
+		{4, []byte{0x04, 0xD2}, "2017-10-24 13:45:07.123400"},
+		{6, []byte{0x01, 0xE2, 0x40}, "2017-10-24 13:45:07.123456"},
+	}
+	for _, tt := range tests {
+		b, err := d.AppendBytes(nil, tt.fsp)
+		if err != nil || !bytes.Equal(b, append(want[:5:5], tt.frac...)) {
+			t.Errorf("AppendBytes(%d) = % x, %v", tt.fsp, b, err)
+		}
+		d2, err := mysql.FromDateTime40Bytes(b, tt.fsp)
+		if err != nil || d2.String() != tt.str {
+			t.Errorf("FromDateTime40Bytes(%d) = %v, %v, want %s", tt.fsp, d2, err, tt.str)
+		}
+	}
+	if _, err := d.AppendBytes(nil, 7); err != mysql.ErrInvalidFSP {
+		t.Errorf("AppendBytes(7) error = %v, want %v", err, mysql.ErrInvalidFSP)
+	}
+	if _, err := mysql.FromDateTime40Bytes(want, 3); err == nil {
+		t.Error("FromDateTime40Bytes() with short fraction succeeded")
+	}
+	if _, err := mysql.FromDateTime40Bytes([]byte{0, 0, 0, 0, 1}, 0); err != mysql.ErrInvalidDate {
+		t.Errorf("FromDateTime40Bytes(negative) error = %v, want %v", err, mysql.ErrInvalidDate)
+	}
+
+	if _, err := mysql.NewDateTime40(2017, 2, 29, 0, 0, 0, 0); err != mysql.ErrInvalidDate {
+		t.Errorf("NewDateTime40(Feb 29) error = %v, want %v", err, mysql.ErrInvalidDate)
+	}
+	if _, err := mysql.NewDateTime40(2017, 1, 1, 24, 0, 0, 0); err != mysql.ErrInvalidDate {
+		t.Errorf("NewDateTime40(hour 24) error = %v, want %v", err, mysql.ErrInvalidDate)
+	}
+
+	trunc := d.Truncate(3)
+	if trunc.Microsecond() != 123000 || !trunc.Before(d) || d.Compare(d) != 0 {
+		t.Errorf("Truncate(3) = %v", trunc)
+	}
+	var zero mysql.DateTime40
+	if z := zero.ToBytes(); !zero.IsZero() || !bytes.Equal(z[:], []byte{0x80, 0, 0, 0, 0}) || !zero.Before(d) {
+		t.Errorf("zero DateTime40 = % x", z)
+	}
+	if _, err := zero.Time(); err != mysql.ErrZeroDate {
+		t.Errorf("Time() error = %v, want %v", err, mysql.ErrZeroDate)
+	}
+}
+
+func TestDateTime40JSONBinary(t *testing.T) {
+	d, _ := mysql.NewDateTime40(2000, 1, 2, 3, 4, 5, 60)
+	data, err := json.Marshal(d)
+	if err != nil || string(data) != `"2000-01-02 03:04:05.000060"` {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+	var d2 mysql.DateTime40
+	if err := json.Unmarshal(data, &d2); err != nil || d2 != d {
+		t.Errorf("json.Unmarshal() = %v, %v", d2, err)
+	}
+	if err := json.Unmarshal([]byte(`"2000-01-02T03:04:05.5"`), &d2); err != nil || d2.Microsecond() != 500000 {
+		t.Errorf("json.Unmarshal(T separator) = %v, %v", d2, err)
+	}
+	if err := json.Unmarshal([]byte(`"2000-01-02 03:04:60"`), &d2); err != mysql.ErrInvalidDate {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, mysql.ErrInvalidDate)
+	}
+
+	bin, err := d.MarshalBinary()
+	if err != nil || len(bin) != 8 {
+		t.Fatalf("MarshalBinary() = % x, %v", bin, err)
+	}
+	var d3 mysql.DateTime40
+	if err := d3.UnmarshalBinary(bin); err != nil || d3 != d {
+		t.Errorf("UnmarshalBinary() = %v, %v", d3, err)
+	}
+	if err := d3.UnmarshalBinary(bin[:5]); err != nil || d3 != d.Truncate(0) {
+		t.Errorf("UnmarshalBinary(5 bytes) = %v, %v", d3, err)
+	}
+}
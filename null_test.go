@@ -0,0 +1,102 @@
+package intx
+
+import (
+	"encoding/json"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+func TestNullJSON(t *testing.T) {
+	type record struct {
+		ID     NullUint48 `json:"id"`
+		Offset NullInt24  `json:"offset"`
+		Size   NullUint40 `json:"size"`
+		Delta  NullInt56  `json:"delta"`
+	}
+	r := record{
+		ID:     NullUint48{Uint48: MustUint48(123456789012345), Valid: true},
+		Offset: NullInt24{Int24: MustInt24(-100), Valid: true},
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"id":123456789012345,"offset":-100,"size":null,"delta":null}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var r2 record
+	r2.Size = NullUint40{Uint40: MustUint40(1), Valid: true}
+	if err := json.Unmarshal(data, &r2); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if r2 != r {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", r2, r)
+	}
+
+	if err := json.Unmarshal([]byte(`{"offset":8388608}`), &r2); err != ErrInt24OutOfRange {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrInt24OutOfRange)
+	}
+}
+
+func TestNullScanValue(t *testing.T) {
+	var n NullInt24
+	if err := n.Scan(int64(-42)); err != nil || !n.Valid || n.Int24.Int64() != -42 {
+		t.Errorf("Scan(-42) = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != int64(-42) {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Value() of null = %v, %v", v, err)
+	}
+	if err := n.Scan(int64(1 << 23)); err != ErrInt24OutOfRange {
+		t.Errorf("Scan() error = %v, want %v", err, ErrInt24OutOfRange)
+	}
+
+	var u NullUint56
+	if err := u.Scan([]byte("72057594037927935")); err != nil || !u.Valid || u.Uint56.Uint64() != 0xFFFFFFFFFFFFFF {
+		t.Errorf("Scan() = %+v, %v", u, err)
+	}
+
+	var i40 NullInt40
+	if err := i40.Scan("-1"); err != nil || !i40.Valid {
+		t.Errorf("NullInt40.Scan() = %+v, %v", i40, err)
+	}
+	var u24 NullUint24
+	if err := u24.Scan(nil); err != nil || u24.Valid {
+		t.Errorf("NullUint24.Scan(nil) = %+v, %v", u24, err)
+	}
+}
+
+func TestNullText(t *testing.T) {
+	n := NullUint48{Uint48: MustUint48(99), Valid: true}
+	text, err := n.MarshalText()
+	if err != nil || string(text) != "99" {
+		t.Errorf("MarshalText() = %q, %v", text, err)
+	}
+	var n2 NullUint48
+	if err := n2.UnmarshalText(text); err != nil || n2 != n {
+		t.Errorf("UnmarshalText() = %+v, %v", n2, err)
+	}
+	if err := n2.UnmarshalText(nil); err != nil || n2.Valid {
+		t.Errorf("UnmarshalText(empty) = %+v, %v", n2, err)
+	}
+	if text, err := n2.MarshalText(); err != nil || len(text) != 0 {
+		t.Errorf("MarshalText() of null = %q, %v", text, err)
+	}
+
+	var i NullInt48
+	if err := i.UnmarshalText([]byte("140737488355328")); err != ErrInt48OutOfRange {
+		t.Errorf("UnmarshalText() error = %v, want %v", err, ErrInt48OutOfRange)
+	}
+}
@@ -0,0 +1,117 @@
+package intx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"unsafe"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+func TestPackedSizes(t *testing.T) {
+	sizes := []struct {
+		name string
+		got  uintptr
+		want uintptr
+	}{
+		{"PackedInt24", unsafe.Sizeof(PackedInt24{}), 3},
+		{"PackedUint24", unsafe.Sizeof(PackedUint24{}), 3},
+		{"PackedInt40", unsafe.Sizeof(PackedInt40{}), 5},
+		{"PackedUint40", unsafe.Sizeof(PackedUint40{}), 5},
+		{"PackedInt48", unsafe.Sizeof(PackedInt48{}), 6},
+		{"PackedUint48", unsafe.Sizeof(PackedUint48{}), 6},
+		{"PackedInt56", unsafe.Sizeof(PackedInt56{}), 7},
+		{"PackedUint56", unsafe.Sizeof(PackedUint56{}), 7},
+		{"[4]PackedUint40", unsafe.Sizeof([4]PackedUint40{}), 20},
+	}
+	for _, s := range sizes {
+		if s.got != s.want {
+			t.Errorf("unsafe.Sizeof(%s) = %d, want %d", s.name, s.got, s.want)
+		}
+	}
+}
+
+func TestPackedRoundTrip(t *testing.T) {
+	for _, v := range []int64{0, 1, -1, 0x7FFFFF, -0x800000} {
+		if got := MustInt24(v).Pack().Unpack().Int64(); got != v {
+			t.Errorf("Int24(%d).Pack().Unpack() = %d", v, got)
+		}
+	}
+	for _, v := range []int64{0, -1, 0x7FFFFFFFFF, -0x8000000000} {
+		if got := MustInt40(v).Pack().Int64(); got != v {
+			t.Errorf("Int40(%d).Pack().Int64() = %d", v, got)
+		}
+	}
+	for _, v := range []int64{0, -1, 0x7FFFFFFFFFFF, -0x800000000000} {
+		if got := MustInt48(v).Pack().Int64(); got != v {
+			t.Errorf("Int48(%d).Pack().Int64() = %d", v, got)
+		}
+	}
+	for _, v := range []int64{0, -1, 0x7FFFFFFFFFFFFF, -0x80000000000000} {
+		if got := MustInt56(v).Pack().Int64(); got != v {
+			t.Errorf("Int56(%d).Pack().Int64() = %d", v, got)
+		}
+	}
+	if got := MustUint24(0xABCDEF).Pack().Uint64(); got != 0xABCDEF {
+		t.Errorf("Uint24.Pack().Uint64() = %x", got)
+	}
+	if got := MustUint40(0xFFFFFFFFFF).Pack().Uint64(); got != 0xFFFFFFFFFF {
+		t.Errorf("Uint40.Pack().Uint64() = %x", got)
+	}
+	if got := MustUint48(0x123456789ABC).Pack().Unpack(); got != MustUint48(0x123456789ABC) {
+		t.Errorf("Uint48.Pack().Unpack() = %v", got)
+	}
+	if got := MustUint56(0xFFFFFFFFFFFFFF).Pack().Uint64(); got != 0xFFFFFFFFFFFFFF {
+		t.Errorf("Uint56.Pack().Uint64() = %x", got)
+	}
+}
+
+func TestPackedMarshaling(t *testing.T) {
+	type row struct {
+		ID     PackedUint48 `json:"id"`
+		Offset PackedInt24  `json:"offset"`
+	}
+	r := row{ID: MustUint48(123456789012345).Pack(), Offset: MustInt24(-100).Pack()}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != `{"id":123456789012345,"offset":-100}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+	var r2 row
+	if err := json.Unmarshal(data, &r2); err != nil || r2 != r {
+		t.Errorf("json.Unmarshal() = %+v, %v, want %+v", r2, err, r)
+	}
+	if err := json.Unmarshal([]byte(`{"offset":8388608}`), &r2); err == nil {
+		t.Error("json.Unmarshal() should fail on out-of-range value")
+	}
+
+	bin, err := r.ID.MarshalBinary()
+	if err != nil || !bytes.Equal(bin, []byte{0x70, 0x48, 0x86, 0x0D, 0xDF, 0x79}) {
+		t.Errorf("MarshalBinary() = %x, %v", bin, err)
+	}
+	var id PackedUint48
+	if err := id.UnmarshalBinary(bin); err != nil || id != r.ID {
+		t.Errorf("UnmarshalBinary() = %v, %v", id, err)
+	}
+	if err := id.UnmarshalBinary(bin[:5]); err != ErrInt48InvalidByteLength {
+		t.Errorf("UnmarshalBinary() error = %v, want %v", err, ErrInt48InvalidByteLength)
+	}
+
+	le, err := Marshal(&r, binary.LittleEndian)
+	if err != nil || !bytes.Equal(le, []byte{0x79, 0xDF, 0x0D, 0x86, 0x48, 0x70, 0x9C, 0xFF, 0xFF}) {
+		t.Errorf("Marshal() = %x, %v", le, err)
+	}
+	var r3 row
+	if err := UnmarshalOrder(le, &r3, binary.LittleEndian); err != nil || r3 != r {
+		t.Errorf("Unmarshal() = %+v, %v, want %+v", r3, err, r)
+	}
+}
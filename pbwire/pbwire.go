@@ -0,0 +1,240 @@
+// Package pbwire appends and consumes intx values in the Protocol Buffers
+// wire format, for hand-written encoders of hot messages. It produces the
+// same bytes as google.golang.org/protobuf/encoding/protowire without
+// importing it.
+//
+// Protobuf has no 24-, 40-, 48- or 56-bit scalar types. Declare intx fields
+// with the following proto types and use the matching helpers:
+//
+//	Go type                 proto type          wire type  helpers
+//	Uint24                  uint32              varint     AppendVarint, ConsumeVarint
+//	Int24                   sint32              varint     AppendSint, ConsumeSint
+//	Uint24 / Int24          fixed32 / sfixed32  fixed32    AppendFixed32, ConsumeFixed32
+//	Uint40, Uint48, Uint56  uint64              varint     AppendVarint, ConsumeVarint
+//	Int40, Int48, Int56     sint64              varint     AppendSint, ConsumeSint
+//	any intx type           fixed64 / sfixed64  fixed64    AppendFixed64, ConsumeFixed64
+//
+// Use sint for signed types: a negative value written with AppendVarint, as
+// an int32 or int64 field, always takes ten bytes. Fixed-width encodings
+// beat varints only when most values are large.
+//
+// Every Consume function range-checks the decoded value against T and
+// returns the width package's ErrXOutOfRange error if it does not fit, so a
+// peer sending a too-large uint64 cannot corrupt a Uint40 field.
+package pbwire
+
+import (
+	"encoding/binary"
+	"errors"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	"github.com/CVDpl/go-intx/internal/conv"
+)
+
+// Common errors for the pbwire package
+var (
+	ErrTruncated          = errors.New("pbwire: truncated value")
+	ErrOverflow           = errors.New("pbwire: varint overflows 64 bits")
+	ErrInvalidFieldNumber = errors.New("pbwire: invalid field number")
+	ErrUnsupportedType    = errors.New("pbwire: unsupported wire type")
+)
+
+// Value is the set of intx types.
+type Value = conv.Value
+
+// Value32 is the set of intx types that fit a fixed32 field.
+type Value32 interface {
+	int24.Int24 | int24.Uint24
+}
+
+// Number is a protobuf field number.
+type Number int32
+
+// Valid field numbers.
+const (
+	MinValidNumber Number = 1
+	MaxValidNumber Number = 1<<29 - 1
+)
+
+// Type is a protobuf wire type.
+type Type int8
+
+// Wire types.
+const (
+	VarintType     Type = 0
+	Fixed64Type    Type = 1
+	BytesType      Type = 2
+	StartGroupType Type = 3
+	EndGroupType   Type = 4
+	Fixed32Type    Type = 5
+)
+
+// AppendTag appends the tag of field num with wire type typ.
+func AppendTag(b []byte, num Number, typ Type) []byte {
+	return binary.AppendUvarint(b, uint64(num)<<3|uint64(typ&7))
+}
+
+// ConsumeTag decodes a field tag from the start of b and returns the field
+// number, wire type and the number of bytes read.
+func ConsumeTag(b []byte) (Number, Type, int, error) {
+	v, n, err := consumeUvarint(b)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	num := v >> 3
+	if num < uint64(MinValidNumber) || num > uint64(MaxValidNumber) {
+		return 0, 0, 0, ErrInvalidFieldNumber
+	}
+	return Number(num), Type(v & 7), n, nil
+}
+
+// SkipFieldValue returns the length of the value of wire type typ at the
+// start of b, so that decoders can skip unknown fields. Groups are not
+// supported.
+func SkipFieldValue(typ Type, b []byte) (int, error) {
+	switch typ {
+	case VarintType:
+		_, n, err := consumeUvarint(b)
+		return n, err
+	case Fixed32Type:
+		if len(b) < 4 {
+			return 0, ErrTruncated
+		}
+		return 4, nil
+	case Fixed64Type:
+		if len(b) < 8 {
+			return 0, ErrTruncated
+		}
+		return 8, nil
+	case BytesType:
+		l, n, err := consumeUvarint(b)
+		if err != nil {
+			return 0, err
+		}
+		if l > uint64(len(b)-n) {
+			return 0, ErrTruncated
+		}
+		return n + int(l), nil
+	}
+	return 0, ErrUnsupportedType
+}
+
+// AppendVarint appends v as a varint, the encoding of uint32, uint64, int32
+// and int64 fields.
+func AppendVarint[T Value](b []byte, v T) []byte {
+	return binary.AppendUvarint(b, uint64(conv.For[T]().ToInt(v)))
+}
+
+// SizeVarint returns the number of bytes AppendVarint uses to encode v.
+func SizeVarint[T Value](v T) int {
+	return uvarintLen(uint64(conv.For[T]().ToInt(v)))
+}
+
+// ConsumeVarint decodes a varint from the start of b into T and returns it
+// with the number of bytes read.
+func ConsumeVarint[T Value](b []byte) (T, int, error) {
+	v, n, err := consumeUvarint(b)
+	if err != nil {
+		var zero T
+		return zero, 0, err
+	}
+	// Values above math.MaxInt64 turn negative and fail the range check of
+	// every intx type.
+	x, err := conv.For[T]().FromInt(int64(v))
+	if err != nil {
+		return x, 0, err
+	}
+	return x, n, nil
+}
+
+// AppendSint appends v as a zigzag varint, the encoding of sint32 and sint64
+// fields.
+func AppendSint[T Value](b []byte, v T) []byte {
+	return binary.AppendUvarint(b, zigzag(conv.For[T]().ToInt(v)))
+}
+
+// SizeSint returns the number of bytes AppendSint uses to encode v.
+func SizeSint[T Value](v T) int {
+	return uvarintLen(zigzag(conv.For[T]().ToInt(v)))
+}
+
+// ConsumeSint decodes a zigzag varint from the start of b into T and returns
+// it with the number of bytes read.
+func ConsumeSint[T Value](b []byte) (T, int, error) {
+	v, n, err := consumeUvarint(b)
+	if err != nil {
+		var zero T
+		return zero, 0, err
+	}
+	x, err := conv.For[T]().FromInt(int64(v>>1) ^ -int64(v&1))
+	if err != nil {
+		return x, 0, err
+	}
+	return x, n, nil
+}
+
+// AppendFixed32 appends v as 4 little-endian bytes, the encoding of fixed32
+// (Uint24) and sfixed32 (Int24) fields.
+func AppendFixed32[T Value32](b []byte, v T) []byte {
+	return binary.LittleEndian.AppendUint32(b, uint32(conv.For[T]().ToInt(v)))
+}
+
+// ConsumeFixed32 decodes a fixed32 or sfixed32 value from the start of b
+// into T and returns it with the number of bytes read.
+func ConsumeFixed32[T Value32](b []byte) (T, int, error) {
+	var zero T
+	if len(b) < 4 {
+		return zero, 0, ErrTruncated
+	}
+	u := binary.LittleEndian.Uint32(b)
+	x := int64(u)
+	if _, signed := any(zero).(int24.Int24); signed {
+		x = int64(int32(u))
+	}
+	v, err := conv.For[T]().FromInt(x)
+	if err != nil {
+		return v, 0, err
+	}
+	return v, 4, nil
+}
+
+// AppendFixed64 appends v as 8 little-endian bytes, the encoding of fixed64
+// and sfixed64 fields.
+func AppendFixed64[T Value](b []byte, v T) []byte {
+	return binary.LittleEndian.AppendUint64(b, uint64(conv.For[T]().ToInt(v)))
+}
+
+// ConsumeFixed64 decodes a fixed64 or sfixed64 value from the start of b
+// into T and returns it with the number of bytes read.
+func ConsumeFixed64[T Value](b []byte) (T, int, error) {
+	if len(b) < 8 {
+		var zero T
+		return zero, 0, ErrTruncated
+	}
+	v, err := conv.For[T]().FromInt(int64(binary.LittleEndian.Uint64(b)))
+	if err != nil {
+		return v, 0, err
+	}
+	return v, 8, nil
+}
+
+func zigzag(x int64) uint64 { return uint64(x<<1) ^ uint64(x>>63) }
+
+func consumeUvarint(b []byte) (uint64, int, error) {
+	v, n := binary.Uvarint(b)
+	switch {
+	case n == 0:
+		return 0, 0, ErrTruncated
+	case n < 0:
+		return 0, 0, ErrOverflow
+	}
+	return v, n, nil
+}
+
+func uvarintLen(v uint64) int {
+	n := 1
+	for ; v >= 0x80; v >>= 7 {
+		n++
+	}
+	return n
+}
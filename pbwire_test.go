@@ -0,0 +1,173 @@
+package intx
+
+import (
+	"bytes"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/pbwire"
+
+	"testing"
+)
+
+func TestPBWireVarint(t *testing.T) {
+	b := pbwire.AppendVarint(nil, MustUint40(300))
+	if want := []byte{0xAC, 0x02}; !bytes.Equal(b, want) {
+		t.Errorf("AppendVarint(300) = % x, want % x", b, want)
+	}
+	if n := pbwire.SizeVarint(MustUint40(300)); n != 2 {
+		t.Errorf("SizeVarint(300) = %d, want 2", n)
+	}
+	v, n, err := pbwire.ConsumeVarint[Uint40](append(b, 0xFF))
+	if err != nil || n != 2 || v.Uint64() != 300 {
+		t.Errorf("ConsumeVarint() = %v, %d, %v", v, n, err)
+	}
+
+	// Negative int32/int64 values take ten bytes.
+	b = pbwire.AppendVarint(nil, MustInt24(-1))
+	if len(b) != 10 || pbwire.SizeVarint(MustInt24(-1)) != 10 {
+		t.Errorf("AppendVarint(-1) = % x", b)
+	}
+	if v, _, err := pbwire.ConsumeVarint[Int24](b); err != nil || v.Int64() != -1 {
+		t.Errorf("ConsumeVarint[Int24]() = %v, %v", v, err)
+	}
+
+	big := pbwire.AppendVarint(nil, MustUint56(1<<56-1))
+	if _, _, err := pbwire.ConsumeVarint[Uint40](big); err != ErrUint40OutOfRange {
+		t.Errorf("ConsumeVarint[Uint40]() error = %v, want %v", err, ErrUint40OutOfRange)
+	}
+	max64 := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01}
+	if _, _, err := pbwire.ConsumeVarint[Uint56](max64); err != ErrUint56OutOfRange {
+		t.Errorf("ConsumeVarint[Uint56](MaxUint64) error = %v, want %v", err, ErrUint56OutOfRange)
+	}
+	if _, _, err := pbwire.ConsumeVarint[Int48](max64); err != nil {
+		t.Errorf("ConsumeVarint[Int48](-1) error = %v", err)
+	}
+	if _, _, err := pbwire.ConsumeVarint[Uint24]([]byte{0x80}); err != pbwire.ErrTruncated {
+		t.Errorf("ConsumeVarint(truncated) error = %v, want %v", err, pbwire.ErrTruncated)
+	}
+	if _, _, err := pbwire.ConsumeVarint[Uint24](bytes.Repeat([]byte{0xFF}, 11)); err != pbwire.ErrOverflow {
+		t.Errorf("ConsumeVarint(overlong) error = %v, want %v", err, pbwire.ErrOverflow)
+	}
+}
+
+func TestPBWireSint(t *testing.T) {
+	tests := []struct {
+		v    int64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{-1, []byte{0x01}},
+		{1, []byte{0x02}},
+		{-64, []byte{0x7F}},
+		{-0x800000, []byte{0xFF, 0xFF, 0xFF, 0x07}},
+	}
+	for _, tt := range tests {
+		b := pbwire.AppendSint(nil, MustInt24(tt.v))
+		if !bytes.Equal(b, tt.want) || pbwire.SizeSint(MustInt24(tt.v)) != len(tt.want) {
+			t.Errorf("AppendSint(%d) = % x, want % x", tt.v, b, tt.want)
+		}
+		v, n, err := pbwire.ConsumeSint[Int24](b)
+		if err != nil || n != len(b) || v.Int64() != tt.v {
+			t.Errorf("ConsumeSint(% x) = %v, %d, %v", b, v, n, err)
+		}
+	}
+
+	b := pbwire.AppendSint(nil, MustInt56(-1<<55))
+	if v, _, err := pbwire.ConsumeSint[Int56](b); err != nil || v.Int64() != -1<<55 {
+		t.Errorf("ConsumeSint[Int56]() = %v, %v", v, err)
+	}
+	if _, _, err := pbwire.ConsumeSint[Int40](b); err != ErrInt40OutOfRange {
+		t.Errorf("ConsumeSint[Int40]() error = %v, want %v", err, ErrInt40OutOfRange)
+	}
+	if _, _, err := pbwire.ConsumeSint[Uint48]([]byte{0x01}); err != ErrUint48OutOfRange {
+		t.Errorf("ConsumeSint[Uint48](-1) error = %v, want %v", err, ErrUint48OutOfRange)
+	}
+}
+
+func TestPBWireFixed(t *testing.T) {
+	b := pbwire.AppendFixed32(nil, MustInt24(-2))
+	if want := []byte{0xFE, 0xFF, 0xFF, 0xFF}; !bytes.Equal(b, want) {
+		t.Errorf("AppendFixed32(-2) = % x, want % x", b, want)
+	}
+	if v, n, err := pbwire.ConsumeFixed32[Int24](b); err != nil || n != 4 || v.Int64() != -2 {
+		t.Errorf("ConsumeFixed32[Int24]() = %v, %d, %v", v, n, err)
+	}
+	if _, _, err := pbwire.ConsumeFixed32[Uint24](b); err != ErrUint24OutOfRange {
+		t.Errorf("ConsumeFixed32[Uint24]() error = %v, want %v", err, ErrUint24OutOfRange)
+	}
+	if v, _, err := pbwire.ConsumeFixed32[Uint24](pbwire.AppendFixed32(nil, MustUint24(0xFFFFFF))); err != nil || v.Uint64() != 0xFFFFFF {
+		t.Errorf("ConsumeFixed32[Uint24]() = %v, %v", v, err)
+	}
+
+	b = pbwire.AppendFixed64(nil, MustUint48(0x0102030405))
+	if want := []byte{0x05, 0x04, 0x03, 0x02, 0x01, 0, 0, 0}; !bytes.Equal(b, want) {
+		t.Errorf("AppendFixed64() = % x, want % x", b, want)
+	}
+	if v, n, err := pbwire.ConsumeFixed64[Uint48](b); err != nil || n != 8 || v.Uint64() != 0x0102030405 {
+		t.Errorf("ConsumeFixed64[Uint48]() = %v, %d, %v", v, n, err)
+	}
+	neg := pbwire.AppendFixed64(nil, MustInt40(-1))
+	if _, _, err := pbwire.ConsumeFixed64[Uint40](neg); err != ErrUint40OutOfRange {
+		t.Errorf("ConsumeFixed64[Uint40](-1) error = %v, want %v", err, ErrUint40OutOfRange)
+	}
+	if _, _, err := pbwire.ConsumeFixed64[Int40](neg[:7]); err != pbwire.ErrTruncated {
+		t.Errorf("ConsumeFixed64(short) error = %v, want %v", err, pbwire.ErrTruncated)
+	}
+}
+
+func TestPBWireMessage(t *testing.T) {
+	// message Sample { uint64 id = 1; sint32 delta = 2; sfixed32 raw = 3; string note = 15; }
+	var b []byte
+	b = pbwire.AppendTag(b, 1, pbwire.VarintType)
+	b = pbwire.AppendVarint(b, MustUint48(150))
+	b = pbwire.AppendTag(b, 2, pbwire.VarintType)
+	b = pbwire.AppendSint(b, MustInt24(-3))
+	b = pbwire.AppendTag(b, 3, pbwire.Fixed32Type)
+	b = pbwire.AppendFixed32(b, MustInt24(7))
+	b = pbwire.AppendTag(b, 15, pbwire.BytesType)
+	b = append(b, 2, 'h', 'i')
+	want := []byte{0x08, 0x96, 0x01, 0x10, 0x05, 0x1D, 0x07, 0, 0, 0, 0x7A, 0x02, 'h', 'i'}
+	if !bytes.Equal(b, want) {
+		t.Fatalf("message = % x, want % x", b, want)
+	}
+
+	var id Uint48
+	var delta, raw Int24
+	for len(b) > 0 {
+		num, typ, n, err := pbwire.ConsumeTag(b)
+		if err != nil {
+			t.Fatalf("ConsumeTag() error = %v", err)
+		}
+		b = b[n:]
+		switch num {
+		case 1:
+			id, n, err = pbwire.ConsumeVarint[Uint48](b)
+		case 2:
+			delta, n, err = pbwire.ConsumeSint[Int24](b)
+		case 3:
+			raw, n, err = pbwire.ConsumeFixed32[Int24](b)
+		default:
+			n, err = pbwire.SkipFieldValue(typ, b)
+		}
+		if err != nil {
+			t.Fatalf("field %d: %v", num, err)
+		}
+		b = b[n:]
+	}
+	if id.Uint64() != 150 || delta.Int64() != -3 || raw.Int64() != 7 {
+		t.Errorf("decoded id=%v delta=%v raw=%v", id, delta, raw)
+	}
+
+	if _, _, _, err := pbwire.ConsumeTag([]byte{0x00}); err != pbwire.ErrInvalidFieldNumber {
+		t.Errorf("ConsumeTag(0) error = %v, want %v", err, pbwire.ErrInvalidFieldNumber)
+	}
+	if _, err := pbwire.SkipFieldValue(pbwire.BytesType, []byte{0x05, 'a'}); err != pbwire.ErrTruncated {
+		t.Errorf("SkipFieldValue(short bytes) error = %v, want %v", err, pbwire.ErrTruncated)
+	}
+	if _, err := pbwire.SkipFieldValue(pbwire.StartGroupType, nil); err != pbwire.ErrUnsupportedType {
+		t.Errorf("SkipFieldValue(group) error = %v, want %v", err, pbwire.ErrUnsupportedType)
+	}
+}
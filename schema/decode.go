@@ -0,0 +1,123 @@
+package schema
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// TruncatedError reports a record cut short by the end of the input.
+type TruncatedError struct {
+	Record *Record
+	Field  *Field // the first field that is incomplete
+	Len    int    // bytes available
+}
+
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("record %s truncated in field %s: have %d of %d bytes",
+		e.Record.Name, e.Field.Name, e.Len, e.Record.Size)
+}
+
+// Decode decodes the fields of a record from the start of b, in declaration
+// order. Bytes after the record are ignored. If b is shorter than the
+// record, Decode returns the fields that are complete and a
+// *TruncatedError.
+func (r *Record) Decode(b []byte) ([]any, error) {
+	values := make([]any, 0, len(r.Fields))
+	for _, f := range r.Fields {
+		end := f.Offset + f.Type.Size()
+		if end > len(b) {
+			return values, &TruncatedError{Record: r, Field: f, Len: len(b)}
+		}
+		v, err := f.Type.Decode(b[f.Offset:end])
+		if err != nil {
+			return values, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// Decode decodes a value of type t from b, which must hold exactly t.Size()
+// bytes. Fields of the intx widths decode to the intx types, such as
+// int24.Uint24, through their FromXBytes and FromXLittleEndianBytes
+// functions; the other widths decode to the built-in integer types.
+func (t Type) Decode(b []byte) (any, error) {
+	if len(b) != t.Size() {
+		return nil, fmt.Errorf("%s needs %d bytes, got %d", t, t.Size(), len(b))
+	}
+	order := binary.ByteOrder(binary.BigEndian)
+	if t.Little {
+		order = binary.LittleEndian
+	}
+	switch {
+	case t.Bits == 8 && t.Signed:
+		return int8(b[0]), nil
+	case t.Bits == 8:
+		return b[0], nil
+	case t.Bits == 16 && t.Signed:
+		return int16(order.Uint16(b)), nil
+	case t.Bits == 16:
+		return order.Uint16(b), nil
+	case t.Bits == 32 && t.Signed:
+		return int32(order.Uint32(b)), nil
+	case t.Bits == 32:
+		return order.Uint32(b), nil
+	case t.Bits == 64 && t.Signed:
+		return int64(order.Uint64(b)), nil
+	case t.Bits == 64:
+		return order.Uint64(b), nil
+	}
+	return decodeIntx(t, b)
+}
+
+func decodeIntx(t Type, b []byte) (any, error) {
+	switch {
+	case t.Bits == 24 && t.Signed && t.Little:
+		return checked(int24.FromInt24LittleEndianBytes(b))
+	case t.Bits == 24 && t.Signed:
+		return checked(int24.FromInt24Bytes(b))
+	case t.Bits == 24 && t.Little:
+		return checked(int24.FromUint24LittleEndianBytes(b))
+	case t.Bits == 24:
+		return checked(int24.FromUint24Bytes(b))
+	case t.Bits == 40 && t.Signed && t.Little:
+		return checked(int40.FromInt40LittleEndianBytes(b))
+	case t.Bits == 40 && t.Signed:
+		return checked(int40.FromInt40Bytes(b))
+	case t.Bits == 40 && t.Little:
+		return checked(int40.FromUint40LittleEndianBytes(b))
+	case t.Bits == 40:
+		return checked(int40.FromUint40Bytes(b))
+	case t.Bits == 48 && t.Signed && t.Little:
+		return checked(int48.FromInt48LittleEndianBytes(b))
+	case t.Bits == 48 && t.Signed:
+		return checked(int48.FromInt48Bytes(b))
+	case t.Bits == 48 && t.Little:
+		return checked(int48.FromUint48LittleEndianBytes(b))
+	case t.Bits == 48:
+		return checked(int48.FromUint48Bytes(b))
+	case t.Bits == 56 && t.Signed && t.Little:
+		return checked(int56.FromInt56LittleEndianBytes(b))
+	case t.Bits == 56 && t.Signed:
+		return checked(int56.FromInt56Bytes(b))
+	case t.Bits == 56 && t.Little:
+		return checked(int56.FromUint56LittleEndianBytes(b))
+	case t.Bits == 56:
+		return checked(int56.FromUint56Bytes(b))
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+// checked returns v as an any, or a nil any if err is set, so that callers
+// never see a typed zero value alongside an error.
+func checked[T any](v T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
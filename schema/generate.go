@@ -0,0 +1,284 @@
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Options configures Generate.
+type Options struct {
+	// Package is the Go package name of the output. It overrides the
+	// schema's package clause.
+	Package string
+
+	// Source is the schema file name recorded in the generated header.
+	Source string
+}
+
+// intxPackages maps widths to the import path of their intx package.
+var intxPackages = map[int]string{
+	24: "github.com/CVDpl/go-intx/24",
+	40: "github.com/CVDpl/go-intx/40",
+	48: "github.com/CVDpl/go-intx/48",
+	56: "github.com/CVDpl/go-intx/56",
+}
+
+// initialisms are rendered in upper case in Go names.
+var initialisms = map[string]string{
+	"crc": "CRC", "id": "ID", "ip": "IP", "tcp": "TCP", "ttl": "TTL", "udp": "UDP",
+}
+
+// exportedName converts a schema name such as "magic_number" to an exported
+// Go name such as "MagicNumber".
+func exportedName(s string) string {
+	var b strings.Builder
+	for part := range strings.SplitSeq(s, "_") {
+		if part == "" {
+			continue
+		}
+		if up, ok := initialisms[strings.ToLower(part)]; ok {
+			b.WriteString(up)
+			continue
+		}
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	name := b.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// goType returns the Go type of t, qualified by its package name.
+func goType(t Type) string {
+	prefix := "u"
+	if t.Signed {
+		prefix = ""
+	}
+	if t.IsIntx() {
+		kind := "Uint"
+		if t.Signed {
+			kind = "Int"
+		}
+		return fmt.Sprintf("int%d.%s%d", t.Bits, kind, t.Bits)
+	}
+	return fmt.Sprintf("%sint%d", prefix, t.Bits)
+}
+
+// Generate returns gofmt-formatted Go source for the records of f.
+//
+// For each record R it emits a struct R, a constant RSize, AppendBinary,
+// MarshalBinary and UnmarshalBinary methods, and a zero-copy RView type over
+// []byte with a getter and setter per field. intx fields are encoded with
+// the width packages' ToBytes/ToLittleEndianBytes methods and decoded with
+// their FromXBytes/FromXLittleEndianBytes functions.
+func Generate(f *File, opts Options) ([]byte, error) {
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = f.Package
+	}
+	if pkg == "" {
+		return nil, errors.New("no package name: add a package clause or set Options.Package")
+	}
+	if err := checkNames(f); err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	widths := map[int]bool{}
+	native := false
+	for _, r := range f.Records {
+		genRecord(&body, r)
+		for _, fld := range r.Fields {
+			if fld.Type.IsIntx() {
+				widths[fld.Type.Bits] = true
+			} else if fld.Type.Bits > 8 {
+				native = true
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	if opts.Source != "" {
+		fmt.Fprintf(&out, "// Code generated by intxgen-schema from %s. DO NOT EDIT.\n\n", opts.Source)
+	} else {
+		fmt.Fprintf(&out, "// Code generated by intxgen-schema. DO NOT EDIT.\n\n")
+	}
+	fmt.Fprintf(&out, "package %s\n\nimport (\n", pkg)
+	if native {
+		fmt.Fprintf(&out, "\t\"encoding/binary\"\n")
+	}
+	fmt.Fprintf(&out, "\t\"fmt\"\n\t\"io\"\n")
+	if len(widths) > 0 {
+		out.WriteString("\n")
+		for _, w := range []int{24, 40, 48, 56} {
+			if widths[w] {
+				fmt.Fprintf(&out, "\tint%d %q\n", w, intxPackages[w])
+			}
+		}
+	}
+	out.WriteString(")\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// checkNames reports Go identifiers that the generated code would declare
+// twice.
+func checkNames(f *File) error {
+	top := map[string]string{}
+	declare := func(name, what string) error {
+		if prev, ok := top[name]; ok {
+			return fmt.Errorf("generated name %s for %s collides with %s", name, what, prev)
+		}
+		top[name] = what
+		return nil
+	}
+	for _, r := range f.Records {
+		name := exportedName(r.Name)
+		for _, n := range []string{name, name + "Size", name + "View", "New" + name + "View"} {
+			if err := declare(n, "record "+r.Name); err != nil {
+				return err
+			}
+		}
+		fieldNames := []string{"AppendBinary", "MarshalBinary", "UnmarshalBinary"}
+		viewNames := []string{"Record"}
+		for _, fld := range r.Fields {
+			n := exportedName(fld.Name)
+			if slices.Contains(fieldNames, n) || slices.Contains(viewNames, n) || slices.Contains(viewNames, "Set"+n) {
+				return fmt.Errorf("record %s: field %s collides with a generated method", r.Name, fld.Name)
+			}
+			fieldNames = append(fieldNames, n)
+			viewNames = append(viewNames, n, "Set"+n)
+		}
+	}
+	return nil
+}
+
+func genRecord(w *bytes.Buffer, r *Record) {
+	name := exportedName(r.Name)
+	size := name + "Size"
+	view := name + "View"
+
+	fmt.Fprintf(w, "\n// %s is the encoded size of a %s in bytes.\nconst %s = %d\n", size, name, size, r.Size)
+
+	fmt.Fprintf(w, "\n// %s is the decoded form of the %s record.\ntype %s struct {\n", name, r.Name, name)
+	for _, f := range r.Fields {
+		fmt.Fprintf(w, "\t%s %s // %s at offset %d\n", exportedName(f.Name), goType(f.Type), f.Type, f.Offset)
+	}
+	w.WriteString("}\n")
+
+	fmt.Fprintf(w, `
+// AppendBinary implements encoding.BinaryAppender for %[1]s.
+func (r %[1]s) AppendBinary(b []byte) ([]byte, error) {
+	n := len(b)
+	b = append(b, make([]byte, %[2]s)...)
+	v := %[3]s(b[n:])
+`, name, size, view)
+	for _, f := range r.Fields {
+		fn := exportedName(f.Name)
+		fmt.Fprintf(w, "\tv.Set%s(r.%s)\n", fn, fn)
+	}
+	fmt.Fprintf(w, `	return b, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for %[1]s.
+func (r %[1]s) MarshalBinary() ([]byte, error) {
+	return r.AppendBinary(make([]byte, 0, %[2]s))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for %[1]s. data must
+// be exactly %[2]s bytes long.
+func (r *%[1]s) UnmarshalBinary(data []byte) error {
+	if len(data) != %[2]s {
+		return fmt.Errorf("unmarshal %[1]s: got %%d bytes, want %%d", len(data), %[2]s)
+	}
+	*r = %[3]s(data).Record()
+	return nil
+}
+
+// %[3]s reads and writes the fields of an encoded %[1]s in place.
+type %[3]s []byte
+
+// New%[3]s returns a view of the first %[2]s bytes of b.
+func New%[3]s(b []byte) (%[3]s, error) {
+	if len(b) < %[2]s {
+		return nil, fmt.Errorf("%%w: %[1]s needs %%d bytes, got %%d", io.ErrUnexpectedEOF, %[2]s, len(b))
+	}
+	return %[3]s(b[:%[2]s:%[2]s]), nil
+}
+
+// Record decodes all fields of the view.
+func (v %[3]s) Record() %[1]s {
+	return %[1]s{
+`, name, size, view)
+	for _, f := range r.Fields {
+		fn := exportedName(f.Name)
+		fmt.Fprintf(w, "\t\t%s: v.%s(),\n", fn, fn)
+	}
+	w.WriteString("\t}\n}\n")
+
+	for _, f := range r.Fields {
+		genAccessors(w, view, f)
+	}
+}
+
+func genAccessors(w *bytes.Buffer, view string, f *Field) {
+	fn := exportedName(f.Name)
+	typ := goType(f.Type)
+	span := fmt.Sprintf("v[%d:%d]", f.Offset, f.Offset+f.Type.Size())
+	order := "binary.BigEndian"
+	if f.Type.Little {
+		order = "binary.LittleEndian"
+	}
+
+	var get, set string
+	switch t := f.Type; {
+	case t.IsIntx():
+		kind := "Uint"
+		if t.Signed {
+			kind = "Int"
+		}
+		from, to := "Bytes", "ToBytes"
+		if t.Little {
+			from, to = "LittleEndianBytes", "ToLittleEndianBytes"
+		}
+		get = fmt.Sprintf("x, _ := int%d.From%s%d%s(%s)\n\treturn x", t.Bits, kind, t.Bits, from, span)
+		set = fmt.Sprintf("b := x.%s()\n\tcopy(%s, b[:])", to, span)
+	case t.Bits == 8 && t.Signed:
+		get = fmt.Sprintf("return int8(v[%d])", f.Offset)
+		set = fmt.Sprintf("v[%d] = byte(x)", f.Offset)
+	case t.Bits == 8:
+		get = fmt.Sprintf("return v[%d]", f.Offset)
+		set = fmt.Sprintf("v[%d] = x", f.Offset)
+	case t.Signed:
+		get = fmt.Sprintf("return int%d(%s.Uint%d(%s))", t.Bits, order, t.Bits, span)
+		set = fmt.Sprintf("%s.PutUint%d(%s, uint%d(x))", order, t.Bits, span, t.Bits)
+	default:
+		get = fmt.Sprintf("return %s.Uint%d(%s)", order, t.Bits, span)
+		set = fmt.Sprintf("%s.PutUint%d(%s, x)", order, t.Bits, span)
+	}
+
+	fmt.Fprintf(w, `
+// %[2]s returns the %[4]s field (%[5]s at offset %[6]d).
+func (v %[1]s) %[2]s() %[3]s {
+	%[7]s
+}
+
+// Set%[2]s sets the %[4]s field.
+func (v %[1]s) Set%[2]s(x %[3]s) {
+	%[8]s
+}
+`, view, fn, typ, f.Name, f.Type, f.Offset, get, set)
+}
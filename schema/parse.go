@@ -0,0 +1,212 @@
+package schema
+
+import (
+	"fmt"
+	"go/token"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Parse parses schema source. Errors are *SyntaxError values carrying the
+// line and column of the problem.
+func Parse(src []byte) (*File, error) {
+	p := &parser{src: src, line: 1, col: 1}
+	return p.file()
+}
+
+type parser struct {
+	src       []byte
+	off       int
+	line, col int
+}
+
+// tok is a lexical token: an identifier or a single punctuation character.
+type tok struct {
+	text      string
+	line, col int
+}
+
+func (p *parser) errorf(t tok, format string, args ...any) error {
+	return &SyntaxError{Line: t.line, Col: t.col, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) advance(n int) {
+	for _, c := range string(p.src[p.off : p.off+n]) {
+		if c == '\n' {
+			p.line++
+			p.col = 1
+		} else {
+			p.col++
+		}
+	}
+	p.off += n
+}
+
+// skipSpace skips whitespace and comments.
+func (p *parser) skipSpace() {
+	for p.off < len(p.src) {
+		c := p.src[p.off]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			p.advance(1)
+		case c == '/' && p.off+1 < len(p.src) && p.src[p.off+1] == '/', c == '#':
+			n := 0
+			for p.off+n < len(p.src) && p.src[p.off+n] != '\n' {
+				n++
+			}
+			p.advance(n)
+		default:
+			return
+		}
+	}
+}
+
+// next returns the next token, or a token with empty text at end of input.
+func (p *parser) next() (tok, error) {
+	p.skipSpace()
+	if p.off == len(p.src) {
+		return tok{line: p.line, col: p.col}, nil
+	}
+	t := tok{line: p.line, col: p.col}
+	r, size := utf8.DecodeRune(p.src[p.off:])
+	switch {
+	case r == '{' || r == '}' || r == ':' || r == ';':
+		t.text = string(r)
+		p.advance(size)
+	case r == '_' || unicode.IsLetter(r):
+		n := 0
+		for p.off+n < len(p.src) {
+			r, size := utf8.DecodeRune(p.src[p.off+n:])
+			if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				break
+			}
+			n += size
+		}
+		t.text = string(p.src[p.off : p.off+n])
+		p.advance(n)
+	default:
+		return t, p.errorf(t, "unexpected character %q", r)
+	}
+	return t, nil
+}
+
+// expect reads the next token and checks that it is want.
+func (p *parser) expect(want string) (tok, error) {
+	t, err := p.next()
+	if err != nil {
+		return t, err
+	}
+	if t.text != want {
+		return t, p.errorf(t, "expected %q, found %s", want, describe(t))
+	}
+	return t, nil
+}
+
+// ident reads the next token and checks that it is an identifier.
+func (p *parser) ident(what string) (tok, error) {
+	t, err := p.next()
+	if err != nil {
+		return t, err
+	}
+	if !token.IsIdentifier(t.text) {
+		return t, p.errorf(t, "expected %s, found %s", what, describe(t))
+	}
+	return t, nil
+}
+
+func describe(t tok) string {
+	if t.text == "" {
+		return "end of file"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+func (p *parser) file() (*File, error) {
+	f := &File{}
+	seen := map[string]bool{}
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch t.text {
+		case "":
+			if len(f.Records) == 0 {
+				return nil, p.errorf(t, "schema declares no records")
+			}
+			return f, nil
+		case "package":
+			if f.Package != "" || len(f.Records) > 0 {
+				return nil, p.errorf(t, "package clause must come first")
+			}
+			name, err := p.ident("package name")
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(";"); err != nil {
+				return nil, err
+			}
+			f.Package = name.text
+		case "record":
+			r, err := p.record()
+			if err != nil {
+				return nil, err
+			}
+			if seen[r.Name] {
+				return nil, p.errorf(t, "record %s declared twice", r.Name)
+			}
+			seen[r.Name] = true
+			f.Records = append(f.Records, r)
+		default:
+			return nil, p.errorf(t, "expected \"record\", found %s", describe(t))
+		}
+	}
+}
+
+func (p *parser) record() (*Record, error) {
+	name, err := p.ident("record name")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	r := &Record{Name: name.text}
+	goNames := map[string]bool{}
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.text == "}" {
+			break
+		}
+		if !token.IsIdentifier(t.text) {
+			return nil, p.errorf(t, "expected field name or \"}\", found %s", describe(t))
+		}
+		if goNames[exportedName(t.text)] {
+			return nil, p.errorf(t, "field %s declared twice in record %s", t.text, r.Name)
+		}
+		goNames[exportedName(t.text)] = true
+		if _, err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		tt, err := p.ident("field type")
+		if err != nil {
+			return nil, err
+		}
+		typ, err := ParseType(tt.text)
+		if err != nil {
+			return nil, p.errorf(tt, "%v", err)
+		}
+		if _, err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		r.Fields = append(r.Fields, &Field{Name: t.text, Type: typ, Offset: r.Size})
+		r.Size += typ.Size()
+	}
+	if len(r.Fields) == 0 {
+		return nil, p.errorf(name, "record %s has no fields", r.Name)
+	}
+	return r, nil
+}
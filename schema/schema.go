@@ -0,0 +1,113 @@
+// Package schema parses a small text language describing fixed-size binary
+// records and generates Go code for them that uses the intx types. Parsed
+// records can also be decoded directly, without generating code.
+//
+// A schema file holds an optional package clause and one or more records:
+//
+//	// Comments run to the end of the line.
+//	package wire;
+//
+//	record Header {
+//		magic:  u24be;
+//		length: u40le;
+//		ts:     i48be;
+//	}
+//
+// Field types are u or i (unsigned or signed), a width of 8, 16, 24, 32,
+// 40, 48, 56 or 64 bits, and a byte order suffix, be or le, which is
+// required for every width except 8. Fields are laid out back to back in
+// declaration order with no padding.
+//
+// The intxgen-schema command wraps Parse and Generate; the intxdump command
+// wraps Parse and Record.Decode.
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// File is a parsed schema.
+type File struct {
+	Package string // from the package clause, or empty
+	Records []*Record
+}
+
+// Record is a fixed-size binary record.
+type Record struct {
+	Name   string
+	Fields []*Field
+	Size   int // encoded size in bytes
+}
+
+// Field is one field of a record.
+type Field struct {
+	Name   string // as written in the schema
+	Type   Type
+	Offset int // byte offset within the record
+}
+
+// Type is a field type.
+type Type struct {
+	Bits   int
+	Signed bool
+	Little bool
+}
+
+// Size returns the encoded size of t in bytes.
+func (t Type) Size() int { return t.Bits / 8 }
+
+// IsIntx reports whether t maps to one of the intx types rather than a
+// built-in Go integer.
+func (t Type) IsIntx() bool {
+	switch t.Bits {
+	case 24, 40, 48, 56:
+		return true
+	}
+	return false
+}
+
+// String returns t in schema syntax, such as "u24be".
+func (t Type) String() string {
+	s := "u"
+	if t.Signed {
+		s = "i"
+	}
+	s += strconv.Itoa(t.Bits)
+	switch {
+	case t.Bits == 8:
+	case t.Little:
+		s += "le"
+	default:
+		s += "be"
+	}
+	return s
+}
+
+// SyntaxError reports a malformed schema.
+type SyntaxError struct {
+	Line, Col int
+	Msg       string
+}
+
+func (e *SyntaxError) Error() string { return fmt.Sprintf("%d:%d: %s", e.Line, e.Col, e.Msg) }
+
+var typePattern = regexp.MustCompile(`^([ui])(8|16|24|32|40|48|56|64)(be|le)?$`)
+
+// ParseType parses a field type such as "u24be" or "i8".
+func ParseType(s string) (Type, error) {
+	m := typePattern.FindStringSubmatch(s)
+	if m == nil {
+		return Type{}, fmt.Errorf("unknown type %q", s)
+	}
+	bits, _ := strconv.Atoi(m[2])
+	t := Type{Bits: bits, Signed: m[1] == "i", Little: m[3] == "le"}
+	if bits == 8 && m[3] != "" {
+		return Type{}, fmt.Errorf("type %q: 8-bit types take no byte order", s)
+	}
+	if bits > 8 && m[3] == "" {
+		return Type{}, fmt.Errorf("type %q needs a byte order suffix, be or le", s)
+	}
+	return t, nil
+}
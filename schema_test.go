@@ -0,0 +1,260 @@
+package intx
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"os"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+	"github.com/CVDpl/go-intx/example/packet"
+	"github.com/CVDpl/go-intx/schema"
+
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files")
+
+// TestSchemaGolden regenerates example/packet from its schema and compares
+// the result with the checked-in file. Run with -update after changing the
+// generator.
+func TestSchemaGolden(t *testing.T) {
+	src, err := os.ReadFile("example/packet/packet.schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := schema.Parse(src)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, err := schema.Generate(f, schema.Options{Source: "packet.schema"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	const golden = "example/packet/packet_gen.go"
+	if *updateGolden {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generated code differs from %s; run go test -run TestSchemaGolden -update", golden)
+	}
+}
+
+func TestSchemaGeneratedPacket(t *testing.T) {
+	p := packet.Packet{Header: MustUint24(0xABCDEF), Length: MustUint40(1024), Checksum: MustUint48(0x123456789ABC)}
+	data, err := p.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+	want := []byte{0xAB, 0xCD, 0xEF, 0, 0, 0, 0x04, 0x00, 0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}
+	if !bytes.Equal(data, want) {
+		t.Errorf("MarshalBinary() = % x, want % x", data, want)
+	}
+
+	var got packet.Packet
+	if err := got.UnmarshalBinary(data); err != nil || got != p {
+		t.Errorf("UnmarshalBinary() = %+v, %v", got, err)
+	}
+	if err := got.UnmarshalBinary(data[:13]); err == nil {
+		t.Error("UnmarshalBinary(short) succeeded")
+	}
+
+	// Views patch fields in place.
+	v, err := packet.NewPacketView(append(data, 0xFF))
+	if err != nil || len(v) != packet.PacketSize {
+		t.Fatalf("NewPacketView() = %d bytes, %v", len(v), err)
+	}
+	v.SetLength(MustUint40(0xFFFFFFFFFF))
+	if v.Length().Uint64() != 0xFFFFFFFFFF || v.Header() != p.Header || v.Checksum() != p.Checksum {
+		t.Errorf("view after SetLength = %+v", v.Record())
+	}
+	if _, err := packet.NewPacketView(data[:5]); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("NewPacketView(short) error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestSchemaGeneratedTelemetry(t *testing.T) {
+	r := packet.Telemetry{
+		Version: 1, Flags: -2, Port: 443, Delta: -300,
+		SensorID:   MustUint24(0x010203),
+		Offset:     MustInt24(-5),
+		Sequence:   7,
+		Bias:       -8,
+		BytesIn:    MustUint40(0x0102030405),
+		Drift:      MustInt40(-9),
+		Timestamp:  MustUint48(0x010203040506),
+		Position:   MustInt48(-10),
+		Counter:    MustUint56(0x01020304050607),
+		Balance:    MustInt56(-11),
+		Total:      1 << 63,
+		Correction: -12,
+	}
+	data, err := r.AppendBinary([]byte{0xEE})
+	if err != nil || len(data) != 1+packet.TelemetrySize {
+		t.Fatalf("AppendBinary() = %d bytes, %v", len(data), err)
+	}
+	data = data[1:]
+	// Spot-check byte orders at the schema's offsets.
+	checks := []struct {
+		off  int
+		want []byte
+	}{
+		{2, []byte{0x01, 0xBB}},                                // u16be 443
+		{4, []byte{0xD4, 0xFE}},                                // i16le -300
+		{6, []byte{0x03, 0x02, 0x01}},                          // u24le
+		{9, []byte{0xFF, 0xFF, 0xFB}},                          // i24be -5
+		{20, []byte{0x05, 0x04, 0x03, 0x02, 0x01}},             // u40le
+		{42, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}}, // u56be
+	}
+	for _, c := range checks {
+		if got := data[c.off : c.off+len(c.want)]; !bytes.Equal(got, c.want) {
+			t.Errorf("bytes at %d = % x, want % x", c.off, got, c.want)
+		}
+	}
+
+	var got packet.Telemetry
+	if err := got.UnmarshalBinary(data); err != nil || got != r {
+		t.Errorf("UnmarshalBinary() = %+v, %v, want %+v", got, err, r)
+	}
+}
+
+// TestSchemaRecordDecode checks that decoding a parsed record matches the
+// generated code for the same schema.
+func TestSchemaRecordDecode(t *testing.T) {
+	src, err := os.ReadFile("example/packet/packet.schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := schema.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := f.Records[1]
+	r := packet.Telemetry{
+		Version: 1, Flags: -2, Port: 443, Delta: -300,
+		SensorID:   MustUint24(0x010203),
+		Offset:     MustInt24(-5),
+		Sequence:   7,
+		Bias:       -8,
+		BytesIn:    MustUint40(0x0102030405),
+		Drift:      MustInt40(-9),
+		Timestamp:  MustUint48(0x010203040506),
+		Position:   MustInt48(-10),
+		Counter:    MustUint56(0x01020304050607),
+		Balance:    MustInt56(-11),
+		Total:      1 << 63,
+		Correction: -12,
+	}
+	data, _ := r.MarshalBinary()
+	got, err := rec.Decode(append(data, 0xEE))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	want := []any{
+		uint8(1), int8(-2), uint16(443), int16(-300),
+		r.SensorID, r.Offset, uint32(7), int32(-8),
+		r.BytesIn, r.Drift, r.Timestamp, r.Position,
+		r.Counter, r.Balance, uint64(1 << 63), int64(-12),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Decode() returned %d values, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("field %s = %#v, want %#v", rec.Fields[i].Name, got[i], want[i])
+		}
+	}
+
+	// Cut the record inside bytes_in (offset 20, 5 bytes).
+	got, err = rec.Decode(data[:22])
+	var te *schema.TruncatedError
+	if !errors.As(err, &te) || te.Field.Name != "bytes_in" || te.Len != 22 || len(got) != 8 {
+		t.Errorf("Decode(22 bytes) = %d values, %v; want 8 values and a truncation in bytes_in", len(got), err)
+	}
+	if err != nil && err.Error() != "record Telemetry truncated in field bytes_in: have 22 of 72 bytes" {
+		t.Errorf("Error() = %q", err)
+	}
+}
+
+func TestSchemaTypeDecode(t *testing.T) {
+	tests := []struct {
+		typ  string
+		in   []byte
+		want any
+	}{
+		{"i24le", []byte{0xC0, 0x1D, 0xFE}, MustInt24(-123456)},
+		{"u24be", []byte{0xFE, 0x1D, 0xC0}, MustUint24(0xFE1DC0)},
+		{"u48be", []byte{0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F}, MustUint48(0x0A0B0C0D0E0F)},
+		{"i56be", []byte{0x80, 0, 0, 0, 0, 0, 0}, MustInt56(-1 << 55)},
+		{"i16be", []byte{0xFF, 0xFE}, int16(-2)},
+	}
+	for _, tt := range tests {
+		typ, err := schema.ParseType(tt.typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := typ.Decode(tt.in); err != nil || got != tt.want {
+			t.Errorf("%s.Decode(% x) = %#v, %v, want %#v", tt.typ, tt.in, got, err, tt.want)
+		}
+	}
+	typ, _ := schema.ParseType("u40le")
+	if got, err := typ.Decode([]byte{1, 2, 3}); got != nil || err == nil || err.Error() != "u40le needs 5 bytes, got 3" {
+		t.Errorf("Decode(3 bytes) = %#v, %v, want nil and a length error", got, err)
+	}
+}
+
+func TestSchemaParseErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"record A { x: u24; }", "1:15: type \"u24\" needs a byte order suffix, be or le"},
+		{"record A { x: u8le; }", "1:15: type \"u8le\": 8-bit types take no byte order"},
+		{"record A { x: u12be; }", "1:15: unknown type \"u12be\""},
+		{"record A {\n  x: u8;\n  x: i8;\n}", "3:3: field x declared twice in record A"},
+		{"record A { x: u8 }", "1:18: expected \";\", found \"}\""},
+		{"record A { }", "1:8: record A has no fields"},
+		{"record A { x: u8; }\nrecord A { y: u8; }", "2:1: record A declared twice"},
+		{"// nothing\n", "2:1: schema declares no records"},
+		{"record A { x: u8; } package p;", "1:21: package clause must come first"},
+		{"record A { x = u8; }", "1:14: unexpected character '='"},
+		{"struct A {}", "1:1: expected \"record\", found \"struct\""},
+	}
+	for _, tt := range tests {
+		_, err := schema.Parse([]byte(tt.src))
+		var se *schema.SyntaxError
+		if !errors.As(err, &se) || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.src, err, tt.want)
+		}
+	}
+}
+
+func TestSchemaGenerateErrors(t *testing.T) {
+	f, err := schema.Parse([]byte("record A { x: u8; set_x: u8; }"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := schema.Generate(f, schema.Options{Package: "p"}); err == nil {
+		t.Error("Generate() with colliding accessor names succeeded")
+	}
+	f, _ = schema.Parse([]byte("record A { x: u8; }"))
+	if _, err := schema.Generate(f, schema.Options{}); err == nil {
+		t.Error("Generate() without a package name succeeded")
+	}
+	src, err := schema.Generate(f, schema.Options{Package: "p"})
+	if err != nil || !bytes.Contains(src, []byte("// Code generated by intxgen-schema. DO NOT EDIT.")) ||
+		bytes.Contains(src, []byte("encoding/binary")) {
+		t.Errorf("Generate() = %s, %v", src, err)
+	}
+}
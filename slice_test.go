@@ -0,0 +1,141 @@
+package intx
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+func TestUint40Slice(t *testing.T) {
+	s := NewUint40Slice(2, binary.LittleEndian)
+	if s.Len() != 2 || len(s.Bytes()) != 10 {
+		t.Fatalf("NewUint40Slice() Len = %d, bytes = %d", s.Len(), len(s.Bytes()))
+	}
+	if err := s.Set(1, MustUint40(0x0102030405)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set(2, MustUint40(1)); err != ErrInt40IndexOutOfRange {
+		t.Errorf("Set() error = %v, want %v", err, ErrInt40IndexOutOfRange)
+	}
+	s = s.Append(MustUint40(0xFFFFFFFFFF), MustUint40(7))
+	if s.Len() != 4 {
+		t.Fatalf("Append() Len = %d, want 4", s.Len())
+	}
+
+	expected := []byte{
+		0, 0, 0, 0, 0,
+		0x05, 0x04, 0x03, 0x02, 0x01,
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+		0x07, 0, 0, 0, 0,
+	}
+	if !bytes.Equal(s.Bytes(), expected) {
+		t.Errorf("Bytes() = %x, want %x", s.Bytes(), expected)
+	}
+
+	want := []uint64{0, 0x0102030405, 0xFFFFFFFFFF, 7}
+	for i, v := range s.All() {
+		if v.Uint64() != want[i] {
+			t.Errorf("All()[%d] = %x, want %x", i, v.Uint64(), want[i])
+		}
+	}
+
+	sub := s.Slice(1, 3)
+	if sub.Len() != 2 || sub.At(0).Uint64() != 0x0102030405 || sub.At(1).Uint64() != 0xFFFFFFFFFF {
+		t.Errorf("Slice(1, 3) = %x", sub.Bytes())
+	}
+	sub.Set(0, MustUint40(9))
+	if s.At(1).Uint64() != 9 {
+		t.Error("Slice() should share the underlying buffer")
+	}
+}
+
+func TestIntSlicesBigEndian(t *testing.T) {
+	i24 := NewInt24Slice(0, nil).Append(MustInt24(-1), MustInt24(0x7FFFFF))
+	if !bytes.Equal(i24.Bytes(), []byte{0xFF, 0xFF, 0xFF, 0x7F, 0xFF, 0xFF}) {
+		t.Errorf("Int24Slice Bytes() = %x", i24.Bytes())
+	}
+	if i24.At(0).Int64() != -1 || i24.Order() != binary.BigEndian {
+		t.Errorf("Int24Slice At(0) = %v, Order() = %v", i24.At(0), i24.Order())
+	}
+
+	i48, err := Int48SliceFromBytes([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}, binary.BigEndian)
+	if err != nil || i48.At(0).Int64() != -2 {
+		t.Errorf("Int48SliceFromBytes() = %v, %v", i48.At(0), err)
+	}
+	if _, err := Uint56SliceFromBytes(make([]byte, 8), nil); err != ErrInt56InvalidByteLength {
+		t.Errorf("Uint56SliceFromBytes() error = %v, want %v", err, ErrInt56InvalidByteLength)
+	}
+
+	var count int
+	for range NewInt56Slice(5, nil).All() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("All() did not stop after break, count = %d", count)
+	}
+}
+
+// TestSliceBoundsAfterReslice checks that At and Slice are bounded by Len,
+// not by the capacity of a shared buffer.
+func TestSliceBoundsAfterReslice(t *testing.T) {
+	full := NewUint40Slice(4, binary.BigEndian)
+	if err := full.Set(3, MustUint40(99)); err != nil {
+		t.Fatal(err)
+	}
+	s := full.Slice(0, 2)
+	if s.Len() != 2 {
+		t.Fatalf("Slice(0, 2).Len() = %d, want 2", s.Len())
+	}
+
+	expectPanic := func(name string, f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != ErrInt40IndexOutOfRange {
+				t.Errorf("%s: recovered %v, want panic(%v)", name, r, ErrInt40IndexOutOfRange)
+			}
+		}()
+		f()
+	}
+	expectPanic("At(3)", func() { s.At(3) })
+	expectPanic("At(2)", func() { s.At(2) })
+	expectPanic("At(-1)", func() { s.At(-1) })
+	expectPanic("Slice(0, 4)", func() { s.Slice(0, 4) })
+	expectPanic("Slice(2, 1)", func() { s.Slice(2, 1) })
+	expectPanic("Slice(-1, 1)", func() { s.Slice(-1, 1) })
+
+	if got := s.Slice(1, 2).At(0); got != full.At(1) {
+		t.Errorf("Slice(1, 2).At(0) = %v, want %v", got, full.At(1))
+	}
+	if got := s.Slice(2, 2).Len(); got != 0 {
+		t.Errorf("Slice(2, 2).Len() = %d, want 0", got)
+	}
+
+	// Every width uses the same checks.
+	for name, f := range map[string]func(){
+		"Int24Slice":  func() { NewInt24Slice(3, nil).Slice(0, 1).At(1) },
+		"Uint24Slice": func() { NewUint24Slice(3, nil).Slice(0, 1).Slice(0, 3) },
+		"Int48Slice":  func() { NewInt48Slice(3, nil).Slice(0, 1).At(2) },
+		"Uint48Slice": func() { NewUint48Slice(3, nil).Slice(0, 1).Slice(0, 2) },
+		"Int56Slice":  func() { NewInt56Slice(3, nil).Slice(0, 1).At(1) },
+		"Uint56Slice": func() { NewUint56Slice(3, nil).Slice(1, 2).Slice(0, 2) },
+		"Int40Slice":  func() { NewInt40Slice(3, nil).Slice(0, 2).At(2) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: indexing past Len did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,149 @@
+package intx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*Int24)(nil)
+	_ sql.Scanner   = (*Uint24)(nil)
+	_ sql.Scanner   = (*Int40)(nil)
+	_ sql.Scanner   = (*Uint40)(nil)
+	_ sql.Scanner   = (*Int48)(nil)
+	_ sql.Scanner   = (*Uint48)(nil)
+	_ sql.Scanner   = (*Int56)(nil)
+	_ sql.Scanner   = (*Uint56)(nil)
+	_ driver.Valuer = Int24{}
+	_ driver.Valuer = Uint24{}
+	_ driver.Valuer = Int40{}
+	_ driver.Valuer = Uint40{}
+	_ driver.Valuer = Int48{}
+	_ driver.Valuer = Uint48{}
+	_ driver.Valuer = Int56{}
+	_ driver.Valuer = Uint56{}
+)
+
+func TestInt24Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    int64
+		wantErr error
+	}{
+		{"int64", int64(-8388608), -8388608, nil},
+		{"uint64", uint64(8388607), 8388607, nil},
+		{"bytes", []byte("-123"), -123, nil},
+		{"string", "456", 456, nil},
+		{"float", float64(-7), -7, nil},
+		{"int64 overflow", int64(8388608), 0, ErrInt24OutOfRange},
+		{"uint64 overflow", uint64(1 << 63), 0, ErrInt24OutOfRange},
+		{"string overflow", "99999999999999999999", 0, ErrInt24OutOfRange},
+		{"float fraction", 1.5, 0, ErrInt24NotIntegral},
+		{"float overflow", 1e30, 0, ErrInt24OutOfRange},
+		{"nil", nil, 0, ErrInt24ScanType},
+		{"bool", true, 0, ErrInt24ScanType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int24
+			err := i.Scan(tt.src)
+			if err != tt.wantErr {
+				t.Fatalf("Scan() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && i.Int64() != tt.want {
+				t.Errorf("Scan() = %v, want %v", i.Int64(), tt.want)
+			}
+		})
+	}
+
+	var i Int24
+	if err := i.Scan("12a"); err == nil {
+		t.Error("Scan() should fail on malformed string")
+	}
+}
+
+func TestUint24Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    uint64
+		wantErr error
+	}{
+		{"int64", int64(16777215), 16777215, nil},
+		{"bytes", []byte("123"), 123, nil},
+		{"float", float64(42), 42, nil},
+		{"negative int64", int64(-1), 0, ErrUint24OutOfRange},
+		{"negative string", "-1", 0, ErrUint24OutOfRange},
+		{"negative float", float64(-1), 0, ErrUint24OutOfRange},
+		{"overflow", uint64(1 << 24), 0, ErrUint24OutOfRange},
+		{"float fraction", 0.25, 0, ErrInt24NotIntegral},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u Uint24
+			err := u.Scan(tt.src)
+			if err != tt.wantErr {
+				t.Fatalf("Scan() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && u.Uint64() != tt.want {
+				t.Errorf("Scan() = %v, want %v", u.Uint64(), tt.want)
+			}
+		})
+	}
+}
+
+func TestScanWiderTypes(t *testing.T) {
+	var u40 Uint40
+	if err := u40.Scan(int64(0xFFFFFFFFFF)); err != nil || u40.Uint64() != 0xFFFFFFFFFF {
+		t.Errorf("Uint40.Scan() = %v, %v", u40, err)
+	}
+	var i48 Int48
+	if err := i48.Scan("-140737488355328"); err != nil || i48.Int64() != -140737488355328 {
+		t.Errorf("Int48.Scan() = %v, %v", i48, err)
+	}
+	var u48 Uint48
+	if err := u48.Scan(int64(1 << 48)); err != ErrUint48OutOfRange {
+		t.Errorf("Uint48.Scan() error = %v, want %v", err, ErrUint48OutOfRange)
+	}
+	var u56 Uint56
+	if err := u56.Scan(uint64(0xFFFFFFFFFFFFFF)); err != nil || u56.Uint64() != 0xFFFFFFFFFFFFFF {
+		t.Errorf("Uint56.Scan() = %v, %v", u56, err)
+	}
+	var i56 Int56
+	if err := i56.Scan(float64(1 << 55)); err != ErrInt56OutOfRange {
+		t.Errorf("Int56.Scan() error = %v, want %v", err, ErrInt56OutOfRange)
+	}
+}
+
+func TestValue(t *testing.T) {
+	values := []struct {
+		v    driver.Valuer
+		want int64
+	}{
+		{MustInt24(-5), -5},
+		{MustUint24(0xFFFFFF), 0xFFFFFF},
+		{MustInt40(-0x8000000000), -0x8000000000},
+		{MustUint40(0xFFFFFFFFFF), 0xFFFFFFFFFF},
+		{MustInt48(7), 7},
+		{MustUint48(0xFFFFFFFFFFFF), 0xFFFFFFFFFFFF},
+		{MustInt56(-0x80000000000000), -0x80000000000000},
+		{MustUint56(0xFFFFFFFFFFFFFF), 0xFFFFFFFFFFFFFF},
+	}
+	for _, tt := range values {
+		got, err := tt.v.Value()
+		if err != nil || got != tt.want {
+			t.Errorf("%T.Value() = %v (%T), %v, want %d", tt.v, got, got, err, tt.want)
+		}
+		if !driver.IsValue(got) {
+			t.Errorf("%T.Value() returned invalid driver.Value %T", tt.v, got)
+		}
+	}
+}
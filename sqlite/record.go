@@ -0,0 +1,271 @@
+package sqlite
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// SerialTypeOf returns the smallest serial type that can hold v. The
+// accepted types are those listed for AppendRecord.
+func SerialTypeOf(v any) (SerialType, error) {
+	switch v := v.(type) {
+	case nil:
+		return TypeNull, nil
+	case float32, float64:
+		return TypeFloat64, nil
+	case string:
+		return TextType(len(v)), nil
+	case []byte:
+		return BlobType(len(v)), nil
+	}
+	x, ok, err := toInt64(v)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("%w: %T", ErrUnsupportedType, v)
+	}
+	return intType(x), nil
+}
+
+// intType returns the smallest integer serial type holding x.
+func intType(x int64) SerialType {
+	switch {
+	case x == 0:
+		return TypeZero
+	case x == 1:
+		return TypeOne
+	case x >= math.MinInt8 && x <= math.MaxInt8:
+		return TypeInt8
+	case x >= math.MinInt16 && x <= math.MaxInt16:
+		return TypeInt16
+	case x >= -1<<23 && x < 1<<23:
+		return TypeInt24
+	case x >= math.MinInt32 && x <= math.MaxInt32:
+		return TypeInt32
+	case x >= -1<<47 && x < 1<<47:
+		return TypeInt48
+	}
+	return TypeInt64
+}
+
+// toInt64 converts integer values, including the intx types and bool, to
+// int64. It reports false if v is not an integer type.
+func toInt64(v any) (int64, bool, error) {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return 1, true, nil
+		}
+		return 0, true, nil
+	case int:
+		return int64(v), true, nil
+	case int8:
+		return int64(v), true, nil
+	case int16:
+		return int64(v), true, nil
+	case int32:
+		return int64(v), true, nil
+	case int64:
+		return v, true, nil
+	case uint:
+		return checkUint(uint64(v))
+	case uint8:
+		return int64(v), true, nil
+	case uint16:
+		return int64(v), true, nil
+	case uint32:
+		return int64(v), true, nil
+	case uint64:
+		return checkUint(v)
+	case int24.Int24:
+		return v.Int64(), true, nil
+	case int24.Uint24:
+		return int64(v.Uint64()), true, nil
+	case int40.Int40:
+		return v.Int64(), true, nil
+	case int40.Uint40:
+		return int64(v.Uint64()), true, nil
+	case int48.Int48:
+		return v.Int64(), true, nil
+	case int48.Uint48:
+		return int64(v.Uint64()), true, nil
+	case int56.Int56:
+		return v.Int64(), true, nil
+	case int56.Uint56:
+		return int64(v.Uint64()), true, nil
+	}
+	return 0, false, nil
+}
+
+func checkUint(v uint64) (int64, bool, error) {
+	if v > math.MaxInt64 {
+		return 0, true, ErrOverflow
+	}
+	return int64(v), true, nil
+}
+
+// AppendRecord appends a record holding values to dst, choosing the
+// smallest serial type for each value.
+//
+// Values may be nil, bool, any Go integer type, any intx type, float32,
+// float64, string (TEXT) or []byte (BLOB). Integers 0 and 1 use serial types
+// 8 and 9, which require schema format 4, the default since SQLite 3.7.10.
+// Unsigned values above math.MaxInt64 return ErrOverflow.
+func AppendRecord(dst []byte, values ...any) ([]byte, error) {
+	types := make([]SerialType, len(values))
+	size := 0
+	for i, v := range values {
+		t, err := SerialTypeOf(v)
+		if err != nil {
+			return dst, fmt.Errorf("sqlite: column %d: %w", i, err)
+		}
+		types[i] = t
+		size += VarintLen(uint64(t))
+	}
+
+	// The header size includes its own varint, whose length depends on
+	// the total.
+	hdrSize := size + VarintLen(uint64(size))
+	if VarintLen(uint64(hdrSize)) != VarintLen(uint64(size)) {
+		hdrSize++
+	}
+	dst = AppendVarint(dst, uint64(hdrSize))
+	for _, t := range types {
+		dst = AppendVarint(dst, uint64(t))
+	}
+
+	for i, v := range values {
+		dst = appendValue(dst, types[i], v)
+	}
+	return dst, nil
+}
+
+func appendValue(dst []byte, t SerialType, v any) []byte {
+	switch v := v.(type) {
+	case float32:
+		return binary.BigEndian.AppendUint64(dst, math.Float64bits(float64(v)))
+	case float64:
+		return binary.BigEndian.AppendUint64(dst, math.Float64bits(v))
+	case string:
+		return append(dst, v...)
+	case []byte:
+		return append(dst, v...)
+	}
+	x, _, _ := toInt64(v)
+	switch t {
+	case TypeInt24:
+		b := int24.MustInt24(x).ToBytes()
+		return append(dst, b[:]...)
+	case TypeInt48:
+		b := int48.MustInt48(x).ToBytes()
+		return append(dst, b[:]...)
+	}
+	n := t.Size()
+	for i := n - 1; i >= 0; i-- {
+		dst = append(dst, byte(x>>(8*i)))
+	}
+	return dst
+}
+
+// ParseHeader decodes the header of the record at the start of data. It
+// returns the column serial types and the offset at which the body starts.
+func ParseHeader(data []byte) ([]SerialType, int, error) {
+	hdrSize, n, err := ReadVarint(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if hdrSize < uint64(n) || hdrSize > uint64(len(data)) {
+		return nil, 0, ErrCorrupt
+	}
+	var types []SerialType
+	for off := n; off < int(hdrSize); {
+		t, m, err := ReadVarint(data[off:hdrSize])
+		if err != nil {
+			return nil, 0, err
+		}
+		if t == 10 || t == 11 {
+			return nil, 0, ErrReservedType
+		}
+		types = append(types, SerialType(t))
+		off += m
+	}
+	return types, int(hdrSize), nil
+}
+
+// DecodeRecord decodes a record into Go values. Each column is returned as
+// the narrowest matching Go type:
+//
+//	0     nil
+//	1     int8
+//	2     int16
+//	3     int24.Int24
+//	4     int32
+//	5     int48.Int48
+//	6     int64
+//	7     float64
+//	8, 9  int8 (0 or 1)
+//	BLOB  []byte (aliasing data)
+//	TEXT  string
+//
+// Bytes after the last column, which SQLite ignores, are ignored here too.
+func DecodeRecord(data []byte) ([]any, error) {
+	types, off, err := ParseHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]any, len(types))
+	for i, t := range types {
+		size := t.Size()
+		if len(data)-off < size {
+			return nil, fmt.Errorf("sqlite: column %d: %w", i, ErrCorrupt)
+		}
+		values[i] = decodeValue(t, data[off:off+size])
+		off += size
+	}
+	return values, nil
+}
+
+// Int64 converts a decoded integer column, of any of the types returned
+// by DecodeRecord, to int64. It reports false if v is not an integer.
+func Int64(v any) (int64, bool) {
+	x, ok, err := toInt64(v)
+	return x, ok && err == nil
+}
+
+func decodeValue(t SerialType, b []byte) any {
+	switch t {
+	case TypeNull:
+		return nil
+	case TypeInt8:
+		return int8(b[0])
+	case TypeInt16:
+		return int16(binary.BigEndian.Uint16(b))
+	case TypeInt24:
+		v, _ := int24.FromInt24Bytes(b)
+		return v
+	case TypeInt32:
+		return int32(binary.BigEndian.Uint32(b))
+	case TypeInt48:
+		v, _ := int48.FromInt48Bytes(b)
+		return v
+	case TypeInt64:
+		return int64(binary.BigEndian.Uint64(b))
+	case TypeFloat64:
+		return math.Float64frombits(binary.BigEndian.Uint64(b))
+	case TypeZero:
+		return int8(0)
+	case TypeOne:
+		return int8(1)
+	}
+	if t.IsBlob() {
+		return b[:len(b):len(b)]
+	}
+	return string(b)
+}
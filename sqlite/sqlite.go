@@ -0,0 +1,163 @@
+// Package sqlite encodes and decodes the SQLite record format, the layout
+// SQLite uses for table rows and index keys inside database pages.
+//
+// A record is a header followed by a body. The header is a varint holding
+// the header's own size in bytes, then one varint serial type per column.
+// The body holds the column values in order, each sized by its serial type.
+// Serial types 3 and 5 are 24- and 48-bit big-endian signed integers, which
+// map directly onto Int24 and Int48.
+//
+// See https://www.sqlite.org/fileformat.html#record_format.
+package sqlite
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Common errors for the sqlite package
+var (
+	ErrCorrupt         = errors.New("sqlite: corrupt record")
+	ErrReservedType    = errors.New("sqlite: reserved serial type")
+	ErrUnsupportedType = errors.New("sqlite: unsupported value type")
+	ErrOverflow        = errors.New("sqlite: integer overflows int64")
+)
+
+// MaxVarintLen is the maximum length of an SQLite varint in bytes.
+const MaxVarintLen = 9
+
+// SerialType describes the storage class and size of one column in a
+// record.
+type SerialType uint64
+
+// Serial types with a fixed meaning. Types 12 and above are BLOBs (even)
+// and TEXT (odd); use BlobType and TextType to build them.
+const (
+	TypeNull    SerialType = 0
+	TypeInt8    SerialType = 1
+	TypeInt16   SerialType = 2
+	TypeInt24   SerialType = 3
+	TypeInt32   SerialType = 4
+	TypeInt48   SerialType = 5
+	TypeInt64   SerialType = 6
+	TypeFloat64 SerialType = 7
+	TypeZero    SerialType = 8 // integer 0, no body bytes (schema format 4)
+	TypeOne     SerialType = 9 // integer 1, no body bytes (schema format 4)
+)
+
+// BlobType returns the serial type of a BLOB of n bytes.
+func BlobType(n int) SerialType { return SerialType(n)*2 + 12 }
+
+// TextType returns the serial type of a TEXT value of n bytes.
+func TextType(n int) SerialType { return SerialType(n)*2 + 13 }
+
+// IsInt reports whether t is an integer serial type.
+func (t SerialType) IsInt() bool {
+	return (t >= TypeInt8 && t <= TypeInt64) || t == TypeZero || t == TypeOne
+}
+
+// IsBlob reports whether t is a BLOB serial type.
+func (t SerialType) IsBlob() bool { return t >= 12 && t%2 == 0 }
+
+// IsText reports whether t is a TEXT serial type.
+func (t SerialType) IsText() bool { return t >= 13 && t%2 == 1 }
+
+// Size returns the number of body bytes a value of type t occupies, or -1
+// for the reserved types 10 and 11.
+func (t SerialType) Size() int {
+	switch t {
+	case TypeNull, TypeZero, TypeOne:
+		return 0
+	case TypeInt8, TypeInt16, TypeInt24, TypeInt32:
+		return int(t)
+	case TypeInt48:
+		return 6
+	case TypeInt64, TypeFloat64:
+		return 8
+	case 10, 11:
+		return -1
+	}
+	return int((t - 12) / 2)
+}
+
+// String returns a short description of t, such as "int24" or "text(5)".
+func (t SerialType) String() string {
+	switch {
+	case t == TypeNull:
+		return "null"
+	case t == TypeInt8:
+		return "int8"
+	case t == TypeInt16:
+		return "int16"
+	case t == TypeInt24:
+		return "int24"
+	case t == TypeInt32:
+		return "int32"
+	case t == TypeInt48:
+		return "int48"
+	case t == TypeInt64:
+		return "int64"
+	case t == TypeFloat64:
+		return "float64"
+	case t == TypeZero:
+		return "zero"
+	case t == TypeOne:
+		return "one"
+	case t.IsBlob():
+		return "blob(" + strconv.Itoa(t.Size()) + ")"
+	case t.IsText():
+		return "text(" + strconv.Itoa(t.Size()) + ")"
+	}
+	return "reserved(" + strconv.FormatUint(uint64(t), 10) + ")"
+}
+
+// AppendVarint appends v in SQLite's varint encoding: big-endian groups of
+// 7 bits with a continuation flag, where a ninth byte carries 8 full bits.
+func AppendVarint(b []byte, v uint64) []byte {
+	if v > 1<<56-1 {
+		// Nine bytes: eight 7-bit groups, then the low 8 bits.
+		for i := 7; i >= 0; i-- {
+			b = append(b, byte(v>>(8+7*i))|0x80)
+		}
+		return append(b, byte(v))
+	}
+	var buf [8]byte
+	i := len(buf) - 1
+	buf[i] = byte(v & 0x7F)
+	for v >>= 7; v != 0; v >>= 7 {
+		i--
+		buf[i] = byte(v&0x7F) | 0x80
+	}
+	return append(b, buf[i:]...)
+}
+
+// VarintLen returns the number of bytes AppendVarint uses to encode v.
+func VarintLen(v uint64) int {
+	if v > 1<<56-1 {
+		return 9
+	}
+	n := 1
+	for v >>= 7; v != 0; v >>= 7 {
+		n++
+	}
+	return n
+}
+
+// ReadVarint decodes a varint from the start of b and returns it with the
+// number of bytes read.
+func ReadVarint(b []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < MaxVarintLen-1; i++ {
+		if i == len(b) {
+			return 0, 0, ErrCorrupt
+		}
+		v = v<<7 | uint64(b[i]&0x7F)
+		if b[i] < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+	if len(b) < MaxVarintLen {
+		return 0, 0, ErrCorrupt
+	}
+	return v<<8 | uint64(b[8]), MaxVarintLen, nil
+}
@@ -0,0 +1,148 @@
+package intx
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	"github.com/CVDpl/go-intx/sqlite"
+
+	"testing"
+)
+
+func TestSQLiteVarint(t *testing.T) {
+	tests := []struct {
+		v    uint64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{0x7F, []byte{0x7F}},
+		{0x80, []byte{0x81, 0x00}},
+		{0x3FFF, []byte{0xFF, 0x7F}},
+		{1<<56 - 1, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x7F}},
+		{1 << 56, []byte{0x80, 0xC0, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00}},
+		{math.MaxUint64, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}},
+	}
+	for _, tt := range tests {
+		b := sqlite.AppendVarint(nil, tt.v)
+		if !bytes.Equal(b, tt.want) {
+			t.Errorf("AppendVarint(%#x) = % x, want % x", tt.v, b, tt.want)
+		}
+		if n := sqlite.VarintLen(tt.v); n != len(tt.want) {
+			t.Errorf("VarintLen(%#x) = %d, want %d", tt.v, n, len(tt.want))
+		}
+		v, n, err := sqlite.ReadVarint(append(b, 0x55))
+		if err != nil || v != tt.v || n != len(tt.want) {
+			t.Errorf("ReadVarint(% x) = %#x, %d, %v", b, v, n, err)
+		}
+		if _, _, err := sqlite.ReadVarint(b[:len(b)-1]); err != sqlite.ErrCorrupt {
+			t.Errorf("ReadVarint(truncated) error = %v, want %v", err, sqlite.ErrCorrupt)
+		}
+	}
+}
+
+func TestSQLiteSerialTypeOf(t *testing.T) {
+	tests := []struct {
+		v    any
+		want sqlite.SerialType
+	}{
+		{nil, sqlite.TypeNull},
+		{0, sqlite.TypeZero},
+		{true, sqlite.TypeOne},
+		{-1, sqlite.TypeInt8},
+		{int16(200), sqlite.TypeInt16},
+		{-8388608, sqlite.TypeInt24},
+		{MustUint24(8388608), sqlite.TypeInt32},
+		{int64(1) << 40, sqlite.TypeInt48},
+		{MustInt48(-1 << 47), sqlite.TypeInt48},
+		{MustUint48(1 << 47), sqlite.TypeInt64},
+		{3.5, sqlite.TypeFloat64},
+		{"hello", sqlite.TextType(5)},
+		{[]byte{1, 2}, sqlite.BlobType(2)},
+	}
+	for _, tt := range tests {
+		got, err := sqlite.SerialTypeOf(tt.v)
+		if err != nil || got != tt.want {
+			t.Errorf("SerialTypeOf(%v) = %v, %v, want %v", tt.v, got, err, tt.want)
+		}
+	}
+	if _, err := sqlite.SerialTypeOf(uint64(math.MaxUint64)); err != sqlite.ErrOverflow {
+		t.Errorf("SerialTypeOf(MaxUint64) error = %v, want %v", err, sqlite.ErrOverflow)
+	}
+	if _, err := sqlite.SerialTypeOf(struct{}{}); !errors.Is(err, sqlite.ErrUnsupportedType) {
+		t.Errorf("SerialTypeOf(struct{}) error = %v, want %v", err, sqlite.ErrUnsupportedType)
+	}
+	if s := sqlite.TextType(5).String(); s != "text(5)" {
+		t.Errorf("String() = %q, want %q", s, "text(5)")
+	}
+}
+
+func TestSQLiteRecord(t *testing.T) {
+	rec, err := sqlite.AppendRecord(nil, nil, 1, -100000, MustInt40(1<<38), "hi", []byte{0xAB}, 2.5)
+	if err != nil {
+		t.Fatalf("AppendRecord() error = %v", err)
+	}
+	want := []byte{
+		0x08,                                     // header size
+		0x00, 0x09, 0x03, 0x05, 0x11, 0x0E, 0x07, // serial types
+		0xFE, 0x79, 0x60, // -100000 as int24
+		0x00, 0x40, 0x00, 0x00, 0x00, 0x00, // 1<<38 as int48
+		'h', 'i',
+		0xAB,
+		0x40, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	if !bytes.Equal(rec, want) {
+		t.Errorf("AppendRecord() = % x, want % x", rec, want)
+	}
+
+	got, err := sqlite.DecodeRecord(rec)
+	if err != nil {
+		t.Fatalf("DecodeRecord() error = %v", err)
+	}
+	wantValues := []any{nil, int8(1), MustInt24(-100000), MustInt48(1 << 38), "hi", []byte{0xAB}, 2.5}
+	if !reflect.DeepEqual(got, wantValues) {
+		t.Errorf("DecodeRecord() = %#v, want %#v", got, wantValues)
+	}
+	if x, ok := sqlite.Int64(got[2]); !ok || x != -100000 {
+		t.Errorf("Int64() = %d, %v", x, ok)
+	}
+
+	types, off, err := sqlite.ParseHeader(rec)
+	if err != nil || off != 8 || len(types) != 7 || types[3] != sqlite.TypeInt48 {
+		t.Errorf("ParseHeader() = %v, %d, %v", types, off, err)
+	}
+
+	if _, err := sqlite.DecodeRecord(rec[:len(rec)-1]); !errors.Is(err, sqlite.ErrCorrupt) {
+		t.Errorf("DecodeRecord(truncated) error = %v, want %v", err, sqlite.ErrCorrupt)
+	}
+	if _, err := sqlite.DecodeRecord([]byte{0x02, 0x0A}); err != sqlite.ErrReservedType {
+		t.Errorf("DecodeRecord(reserved) error = %v, want %v", err, sqlite.ErrReservedType)
+	}
+	if _, err := sqlite.DecodeRecord([]byte{0x05, 0x01}); err != sqlite.ErrCorrupt {
+		t.Errorf("DecodeRecord(bad header size) error = %v, want %v", err, sqlite.ErrCorrupt)
+	}
+}
+
+func TestSQLiteRecordLongHeader(t *testing.T) {
+	// 127 one-byte serial types push the header size to a 2-byte varint.
+	values := make([]any, 127)
+	for i := range values {
+		values[i] = int8(-2)
+	}
+	rec, err := sqlite.AppendRecord(nil, values...)
+	if err != nil {
+		t.Fatalf("AppendRecord() error = %v", err)
+	}
+	types, off, err := sqlite.ParseHeader(rec)
+	if err != nil || off != 129 || len(types) != 127 {
+		t.Fatalf("ParseHeader() = %d types, %d, %v", len(types), off, err)
+	}
+	got, err := sqlite.DecodeRecord(rec)
+	if err != nil || len(got) != 127 || got[126] != int8(-2) {
+		t.Errorf("DecodeRecord() = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,227 @@
+package intx
+
+import (
+	int24 "github.com/CVDpl/go-intx/24"
+	int40 "github.com/CVDpl/go-intx/40"
+	int48 "github.com/CVDpl/go-intx/48"
+	int56 "github.com/CVDpl/go-intx/56"
+)
+
+// String is a byte string being parsed, in the style of
+// golang.org/x/crypto/cryptobyte. Each Read method consumes bytes from the
+// front of the String and reports whether it succeeded; on failure the
+// String is left unchanged. All integers are read in big-endian order.
+type String []byte
+
+// read advances the String by n bytes and returns them, or nil if fewer
+// than n bytes remain.
+func (s *String) read(n int) []byte {
+	if n < 0 || len(*s) < n {
+		return nil
+	}
+	v := (*s)[:n:n]
+	*s = (*s)[n:]
+	return v
+}
+
+// Skip advances the String by n bytes.
+func (s *String) Skip(n int) bool { return s.read(n) != nil }
+
+// Empty reports whether the String has no more bytes to read.
+func (s String) Empty() bool { return len(s) == 0 }
+
+// ReadUint8 reads an 8-bit value into out.
+func (s *String) ReadUint8(out *uint8) bool {
+	v := s.read(1)
+	if v == nil {
+		return false
+	}
+	*out = v[0]
+	return true
+}
+
+// ReadUint16 reads a big-endian 16-bit value into out.
+func (s *String) ReadUint16(out *uint16) bool {
+	v := s.read(2)
+	if v == nil {
+		return false
+	}
+	*out = uint16(v[0])<<8 | uint16(v[1])
+	return true
+}
+
+// ReadUint32 reads a big-endian 32-bit value into out.
+func (s *String) ReadUint32(out *uint32) bool {
+	v := s.read(4)
+	if v == nil {
+		return false
+	}
+	*out = uint32(v[0])<<24 | uint32(v[1])<<16 | uint32(v[2])<<8 | uint32(v[3])
+	return true
+}
+
+// ReadUint64 reads a big-endian 64-bit value into out.
+func (s *String) ReadUint64(out *uint64) bool {
+	v := s.read(8)
+	if v == nil {
+		return false
+	}
+	*out = uint64(v[0])<<56 | uint64(v[1])<<48 | uint64(v[2])<<40 | uint64(v[3])<<32 |
+		uint64(v[4])<<24 | uint64(v[5])<<16 | uint64(v[6])<<8 | uint64(v[7])
+	return true
+}
+
+// ReadUint24 reads a 3-byte big-endian Uint24 into out.
+func (s *String) ReadUint24(out *int24.Uint24) bool {
+	v := s.read(3)
+	if v == nil {
+		return false
+	}
+	*out, _ = int24.FromUint24Bytes(v)
+	return true
+}
+
+// ReadInt24 reads a 3-byte big-endian Int24 into out.
+func (s *String) ReadInt24(out *int24.Int24) bool {
+	v := s.read(3)
+	if v == nil {
+		return false
+	}
+	*out, _ = int24.FromInt24Bytes(v)
+	return true
+}
+
+// ReadUint40 reads a 5-byte big-endian Uint40 into out.
+func (s *String) ReadUint40(out *int40.Uint40) bool {
+	v := s.read(5)
+	if v == nil {
+		return false
+	}
+	*out, _ = int40.FromUint40Bytes(v)
+	return true
+}
+
+// ReadInt40 reads a 5-byte big-endian Int40 into out.
+func (s *String) ReadInt40(out *int40.Int40) bool {
+	v := s.read(5)
+	if v == nil {
+		return false
+	}
+	*out, _ = int40.FromInt40Bytes(v)
+	return true
+}
+
+// ReadUint48 reads a 6-byte big-endian Uint48 into out.
+func (s *String) ReadUint48(out *int48.Uint48) bool {
+	v := s.read(6)
+	if v == nil {
+		return false
+	}
+	*out, _ = int48.FromUint48Bytes(v)
+	return true
+}
+
+// ReadInt48 reads a 6-byte big-endian Int48 into out.
+func (s *String) ReadInt48(out *int48.Int48) bool {
+	v := s.read(6)
+	if v == nil {
+		return false
+	}
+	*out, _ = int48.FromInt48Bytes(v)
+	return true
+}
+
+// ReadUint56 reads a 7-byte big-endian Uint56 into out.
+func (s *String) ReadUint56(out *int56.Uint56) bool {
+	v := s.read(7)
+	if v == nil {
+		return false
+	}
+	*out, _ = int56.FromUint56Bytes(v)
+	return true
+}
+
+// ReadInt56 reads a 7-byte big-endian Int56 into out.
+func (s *String) ReadInt56(out *int56.Int56) bool {
+	v := s.read(7)
+	if v == nil {
+		return false
+	}
+	*out, _ = int56.FromInt56Bytes(v)
+	return true
+}
+
+// ReadBytes reads n bytes into out, sharing the String's backing array.
+func (s *String) ReadBytes(out *[]byte, n int) bool {
+	v := s.read(n)
+	if v == nil {
+		return false
+	}
+	*out = v
+	return true
+}
+
+// CopyBytes copies len(out) bytes into out.
+func (s *String) CopyBytes(out []byte) bool {
+	v := s.read(len(out))
+	if v == nil {
+		return false
+	}
+	copy(out, v)
+	return true
+}
+
+// ReadUint8LengthPrefixed reads a section prefixed by a 1-byte length into out.
+func (s *String) ReadUint8LengthPrefixed(out *String) bool {
+	var n uint8
+	c := *s
+	if !c.ReadUint8(&n) {
+		return false
+	}
+	return s.readSection(c, uint64(n), out)
+}
+
+// ReadUint16LengthPrefixed reads a section prefixed by a 2-byte big-endian
+// length into out.
+func (s *String) ReadUint16LengthPrefixed(out *String) bool {
+	var n uint16
+	c := *s
+	if !c.ReadUint16(&n) {
+		return false
+	}
+	return s.readSection(c, uint64(n), out)
+}
+
+// ReadUint24LengthPrefixed reads a section prefixed by a 3-byte big-endian
+// Uint24 length into out.
+func (s *String) ReadUint24LengthPrefixed(out *String) bool {
+	var n int24.Uint24
+	c := *s
+	if !c.ReadUint24(&n) {
+		return false
+	}
+	return s.readSection(c, n.Uint64(), out)
+}
+
+// ReadUint32LengthPrefixed reads a section prefixed by a 4-byte big-endian
+// length into out.
+func (s *String) ReadUint32LengthPrefixed(out *String) bool {
+	var n uint32
+	c := *s
+	if !c.ReadUint32(&n) {
+		return false
+	}
+	return s.readSection(c, uint64(n), out)
+}
+
+// readSection reads n bytes from rest, the remainder of s after its length
+// prefix, into out and commits the read to s only on success.
+func (s *String) readSection(rest String, n uint64, out *String) bool {
+	if n > uint64(len(rest)) {
+		return false
+	}
+	v := rest.read(int(n))
+	*out = String(v)
+	*s = rest
+	return true
+}
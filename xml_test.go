@@ -0,0 +1,95 @@
+package intx
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+
+	. "github.com/CVDpl/go-intx/24"
+	. "github.com/CVDpl/go-intx/40"
+	. "github.com/CVDpl/go-intx/48"
+	. "github.com/CVDpl/go-intx/56"
+
+	"testing"
+)
+
+type xmlDevice struct {
+	XMLName xml.Name `xml:"device"`
+	ID      Uint48   `xml:"id,attr"`
+	Offset  Int24    `xml:"offset,attr"`
+	Serial  Uint40   `xml:"serial"`
+	Drift   Int56    `xml:"drift"`
+	Limit   *Uint24  `xml:"limit,omitempty"`
+	Ports   []Int40  `xml:"ports>port"`
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	limit := MustUint24(0xFFFFFF)
+	d := xmlDevice{
+		ID:     MustUint48(0xFFFFFFFFFFFF),
+		Offset: MustInt24(-8388608),
+		Serial: MustUint40(123456789012),
+		Drift:  MustInt56(-42),
+		Limit:  &limit,
+		Ports:  []Int40{MustInt40(80), MustInt40(-1)},
+	}
+	data, err := xml.Marshal(d)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+	want := `<device id="281474976710655" offset="-8388608"><serial>123456789012</serial>` +
+		`<drift>-42</drift><limit>16777215</limit><ports><port>80</port><port>-1</port></ports></device>`
+	if string(data) != want {
+		t.Errorf("xml.Marshal() = %s, want %s", data, want)
+	}
+
+	var got xmlDevice
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if got.ID != d.ID || got.Offset != d.Offset || got.Serial != d.Serial || got.Drift != d.Drift ||
+		got.Limit == nil || *got.Limit != limit || len(got.Ports) != 2 || got.Ports[1] != d.Ports[1] {
+		t.Errorf("xml.Unmarshal() = %+v, want %+v", got, d)
+	}
+}
+
+func TestXMLWhitespace(t *testing.T) {
+	var d xmlDevice
+	data := `<device id=" 7 " offset="-3"><serial>
+		42
+	</serial><drift>0</drift></device>`
+	if err := xml.Unmarshal([]byte(data), &d); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if d.ID.Uint64() != 7 || d.Offset.Int64() != -3 || d.Serial.Uint64() != 42 || d.Limit != nil {
+		t.Errorf("xml.Unmarshal() = %+v", d)
+	}
+}
+
+func TestXMLRangeErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		want error
+	}{
+		{`<device id="281474976710656"/>`, ErrUint48OutOfRange},
+		{`<device id="-1"/>`, ErrUint48OutOfRange},
+		{`<device offset="8388608"/>`, ErrInt24OutOfRange},
+		{`<device offset="-99999999999999999999"/>`, ErrInt24OutOfRange},
+		{`<device><serial>1099511627776</serial></device>`, ErrUint40OutOfRange},
+		{`<device><drift>36028797018963968</drift></device>`, ErrInt56OutOfRange},
+		{`<device><limit>16777216</limit></device>`, ErrUint24OutOfRange},
+		{`<device><ports><port>549755813888</port></ports></device>`, ErrInt40OutOfRange},
+	}
+	for _, tt := range tests {
+		var d xmlDevice
+		if err := xml.Unmarshal([]byte(tt.data), &d); err != tt.want {
+			t.Errorf("xml.Unmarshal(%s) error = %v, want %v", tt.data, err, tt.want)
+		}
+	}
+
+	var d xmlDevice
+	err := xml.Unmarshal([]byte(`<device id="12ab"/>`), &d)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("xml.Unmarshal(syntax) error = %v, want %v", err, strconv.ErrSyntax)
+	}
+}